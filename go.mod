@@ -3,8 +3,11 @@ module github.com/zalopay-oss/terraform-provider-nacos
 go 1.16
 
 require (
+	github.com/hashicorp/hcl/v2 v2.12.0
 	github.com/hashicorp/terraform-plugin-docs v0.10.1
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.17.0
 	github.com/stretchr/testify v1.7.0
+	github.com/zclconf/go-cty v1.10.0
 	google.golang.org/genproto v0.0.0-20200825200019-8632dd797987 // indirect
+	gopkg.in/yaml.v3 v3.0.0
 )
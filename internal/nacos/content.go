@@ -0,0 +1,405 @@
+package nacos
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+	"gopkg.in/yaml.v3"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+// suppressEquivalentContent hides differences of whitespace, key order or line endings
+// which do not change the meaning of the content for its type
+func suppressEquivalentContent(_, old, new string, d *schema.ResourceData) bool {
+	if d.Get("strict_value_comparison").(bool) || old == "" || new == "" {
+		return false
+	}
+	return contentEquivalent(d.Get("type").(string), old, new)
+}
+
+func contentEquivalent(contentType, a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	switch contentType {
+	case nacos.ConfigurationTypeJSON:
+		var va, vb interface{}
+		if !decodeJSON(a, &va) || !decodeJSON(b, &vb) {
+			return false
+		}
+		return reflect.DeepEqual(va, vb)
+
+	case nacos.ConfigurationTypeYAML:
+		va, err := decodeYAMLDocuments(a)
+		if err != nil {
+			return false
+		}
+		vb, err := decodeYAMLDocuments(b)
+		if err != nil {
+			return false
+		}
+		return reflect.DeepEqual(va, vb)
+
+	case nacos.ConfigurationTypeProperties:
+		return reflect.DeepEqual(parseProperties(a), parseProperties(b))
+
+	default:
+		return normalizeText(a) == normalizeText(b)
+	}
+}
+
+func decodeJSON(s string, v interface{}) bool {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	return decoder.Decode(v) == nil && !decoder.More()
+}
+
+// decodeYAMLDocuments decodes every document separated by `---`, e.g. the profiles of a Spring configuration
+func decodeYAMLDocuments(s string) ([]interface{}, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(s))
+	var documents []interface{}
+	for {
+		var v interface{}
+		if err := decoder.Decode(&v); err == io.EOF {
+			return documents, nil
+		} else if err != nil {
+			return nil, err
+		}
+		documents = append(documents, v)
+	}
+}
+
+// normalizeText unifies line endings and drops trailing whitespace
+func normalizeText(s string) string {
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// parseProperties reads a Java properties document: comments, `=`/`:`/whitespace separators and continuation lines
+func parseProperties(s string) map[string]string {
+	properties := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader([]byte(s)))
+
+	var logical string
+	for scanner.Scan() {
+		line := strings.TrimLeft(scanner.Text(), " \t\f")
+		if logical == "" && (line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!")) {
+			continue
+		}
+		line = strings.TrimRight(line, "\r")
+
+		if strings.HasSuffix(line, "\\") && !strings.HasSuffix(line, "\\\\") {
+			logical += strings.TrimSuffix(line, "\\")
+			continue
+		}
+		logical += line
+
+		key, value := splitProperty(logical)
+		properties[key] = value
+		logical = ""
+	}
+	if logical != "" {
+		key, value := splitProperty(logical)
+		properties[key] = value
+	}
+
+	return properties
+}
+
+func splitProperty(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; c {
+		case '\\':
+			// an escaped character is part of the key
+			i++
+		case '=', ':', ' ', '\t', '\f':
+			value := strings.TrimLeft(line[i+1:], " \t\f")
+			if c != '=' && c != ':' && value != "" && (value[0] == '=' || value[0] == ':') {
+				value = value[1:]
+			}
+			return line[:i], strings.TrimSpace(value)
+		}
+	}
+	return line, ""
+}
+
+// suppressEquivalentJSON hides differences of formatting between two JSON documents
+func suppressEquivalentJSON(_, old, new string, _ *schema.ResourceData) bool {
+	return contentEquivalent(nacos.ConfigurationTypeJSON, old, new)
+}
+
+// renderContentObject renders a JSON encoded object into a document of contentType.
+// Keys are sorted, so that the same object always renders to the same content.
+func renderContentObject(contentType, object string) (string, error) {
+	var v interface{}
+	if !decodeJSON(object, &v) {
+		return "", fmt.Errorf("content_object is not a valid JSON document")
+	}
+
+	switch contentType {
+	case nacos.ConfigurationTypeJSON:
+		buf := new(bytes.Buffer)
+		encoder := json.NewEncoder(buf)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(v); err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(buf.String(), "\n"), nil
+
+	case nacos.ConfigurationTypeYAML:
+		buf := new(bytes.Buffer)
+		encoder := yaml.NewEncoder(buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(convertJSONNumbers(v)); err != nil {
+			return "", err
+		}
+		if err := encoder.Close(); err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(buf.String(), "\n"), nil
+
+	case nacos.ConfigurationTypeProperties:
+		properties := map[string]string{}
+		flattenProperties("", v, properties)
+		keys := make([]string, 0, len(properties))
+		for k := range properties {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		lines := make([]string, 0, len(keys))
+		for _, k := range keys {
+			lines = append(lines, escapePropertyKey(k)+"="+escapePropertyValue(properties[k]))
+		}
+		return strings.Join(lines, "\n"), nil
+
+	default:
+		return "", validateContentObjectType(contentType)
+	}
+}
+
+func validateContentObjectType(contentType string) error {
+	switch contentType {
+	case nacos.ConfigurationTypeJSON, nacos.ConfigurationTypeYAML, nacos.ConfigurationTypeProperties:
+		return nil
+	default:
+		return fmt.Errorf("content_object requires type %s, %s or %s, got %q",
+			nacos.ConfigurationTypeJSON, nacos.ConfigurationTypeYAML, nacos.ConfigurationTypeProperties, contentType)
+	}
+}
+
+// contentToObject parses content of contentType into a JSON encoded object, the reverse of renderContentObject
+func contentToObject(contentType, content string) (string, error) {
+	var v interface{}
+	switch contentType {
+	case nacos.ConfigurationTypeJSON:
+		if !decodeJSON(content, &v) {
+			return "", fmt.Errorf("content is not a valid JSON document")
+		}
+	case nacos.ConfigurationTypeYAML:
+		documents, err := decodeYAMLDocuments(content)
+		if err != nil {
+			return "", err
+		}
+		// content_object renders a single document
+		if len(documents) > 1 {
+			return "", fmt.Errorf("content has %d YAML documents", len(documents))
+		}
+		if len(documents) == 1 {
+			v = documents[0]
+		}
+	case nacos.ConfigurationTypeProperties:
+		v = parseProperties(content)
+	default:
+		return "", fmt.Errorf("unsupported type %q", contentType)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// convertJSONNumbers turns json.Number into int64 or float64, so that they are not rendered as strings
+func convertJSONNumbers(v interface{}) interface{} {
+	switch value := v.(type) {
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		}
+		f, _ := value.Float64()
+		return f
+	case map[string]interface{}:
+		for k, item := range value {
+			value[k] = convertJSONNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = convertJSONNumbers(item)
+		}
+	}
+	return v
+}
+
+// flattenProperties uses the Spring convention: `parent.child` for objects and `parent[0]` for lists
+func flattenProperties(prefix string, v interface{}, properties map[string]string) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			flattenProperties(key, item, properties)
+		}
+	case []interface{}:
+		for i, item := range value {
+			flattenProperties(fmt.Sprintf("%s[%d]", prefix, i), item, properties)
+		}
+	case nil:
+		properties[prefix] = ""
+	case string:
+		properties[prefix] = value
+	case json.Number:
+		properties[prefix] = value.String()
+	case bool:
+		properties[prefix] = strconv.FormatBool(value)
+	default:
+		properties[prefix] = fmt.Sprint(value)
+	}
+}
+
+func escapePropertyKey(k string) string {
+	return strings.NewReplacer(`\`, `\\`, " ", `\ `, "=", `\=`, ":", `\:`, "\n", `\n`).Replace(k)
+}
+
+func escapePropertyValue(v string) string {
+	v = strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\r", `\r`).Replace(v)
+	if strings.HasPrefix(v, " ") {
+		v = `\` + v
+	}
+	return v
+}
+
+// renderSourceFile reads a local file, which is rendered with the syntax of templatefile() when vars are given
+func renderSourceFile(path string, vars map[string]interface{}) (string, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read source_file: %v", err)
+	}
+	if len(vars) == 0 {
+		return string(src), nil
+	}
+
+	expr, diags := hclsyntax.ParseTemplate(src, path, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return "", fmt.Errorf("failed to parse template %s: %s", path, diags.Error())
+	}
+
+	variables := make(map[string]cty.Value, len(vars))
+	for k, v := range vars {
+		variables[k] = cty.StringVal(v.(string))
+	}
+	value, diags := expr.Value(&hcl.EvalContext{Variables: variables, Functions: templateFunctions})
+	if diags.HasErrors() {
+		return "", fmt.Errorf("failed to render template %s: %s", path, diags.Error())
+	}
+	if value.IsNull() || !value.Type().Equals(cty.String) {
+		return "", fmt.Errorf("template %s did not render to a string", path)
+	}
+
+	return value.AsString(), nil
+}
+
+// templateFunctions are the functions of templatefile() which do not depend on Terraform itself,
+// e.g. file() or templatefile() are not available
+var templateFunctions = map[string]function.Function{
+	"abs":        stdlib.AbsoluteFunc,
+	"ceil":       stdlib.CeilFunc,
+	"chomp":      stdlib.ChompFunc,
+	"coalesce":   stdlib.CoalesceFunc,
+	"compact":    stdlib.CompactFunc,
+	"concat":     stdlib.ConcatFunc,
+	"contains":   stdlib.ContainsFunc,
+	"csvdecode":  stdlib.CSVDecodeFunc,
+	"distinct":   stdlib.DistinctFunc,
+	"element":    stdlib.ElementFunc,
+	"flatten":    stdlib.FlattenFunc,
+	"floor":      stdlib.FloorFunc,
+	"format":     stdlib.FormatFunc,
+	"formatdate": stdlib.FormatDateFunc,
+	"formatlist": stdlib.FormatListFunc,
+	"indent":     stdlib.IndentFunc,
+	"join":       stdlib.JoinFunc,
+	"jsondecode": stdlib.JSONDecodeFunc,
+	"jsonencode": stdlib.JSONEncodeFunc,
+	"keys":       stdlib.KeysFunc,
+	"length":     stdlib.LengthFunc,
+	"lookup":     stdlib.LookupFunc,
+	"lower":      stdlib.LowerFunc,
+	"max":        stdlib.MaxFunc,
+	"merge":      stdlib.MergeFunc,
+	"min":        stdlib.MinFunc,
+	"parseint":   stdlib.ParseIntFunc,
+	"range":      stdlib.RangeFunc,
+	"regex":      stdlib.RegexFunc,
+	"regexall":   stdlib.RegexAllFunc,
+	"replace":    stdlib.ReplaceFunc,
+	"reverse":    stdlib.ReverseListFunc,
+	"slice":      stdlib.SliceFunc,
+	"sort":       stdlib.SortFunc,
+	"split":      stdlib.SplitFunc,
+	"strrev":     stdlib.ReverseFunc,
+	"substr":     stdlib.SubstrFunc,
+	"title":      stdlib.TitleFunc,
+	"tobool":     stdlib.MakeToFunc(cty.Bool),
+	"tonumber":   stdlib.MakeToFunc(cty.Number),
+	"tostring":   stdlib.MakeToFunc(cty.String),
+	"trim":       stdlib.TrimFunc,
+	"trimprefix": stdlib.TrimPrefixFunc,
+	"trimspace":  stdlib.TrimSpaceFunc,
+	"trimsuffix": stdlib.TrimSuffixFunc,
+	"upper":      stdlib.UpperFunc,
+	"values":     stdlib.ValuesFunc,
+	"zipmap":     stdlib.ZipmapFunc,
+}
+
+// inferContentType maps the extension of a file to the content type, defaults to text
+func inferContentType(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return nacos.ConfigurationTypeJSON
+	case ".yaml", ".yml":
+		return nacos.ConfigurationTypeYAML
+	case ".properties":
+		return nacos.ConfigurationTypeProperties
+	case ".xml":
+		return nacos.ConfigurationTypeXML
+	case ".html", ".htm":
+		return nacos.ConfigurationTypeHTML
+	default:
+		return nacos.ConfigurationTypeText
+	}
+}
@@ -0,0 +1,231 @@
+package nacos
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func TestContentEquivalent(t *testing.T) {
+	testcases := []struct {
+		name        string
+		contentType string
+		a, b        string
+		equivalent  bool
+	}{
+		{
+			name:        "text line endings",
+			contentType: nacos.ConfigurationTypeText,
+			a:           "line 1\r\nline 2\r\n",
+			b:           "line 1\nline 2",
+			equivalent:  true,
+		},
+		{
+			name:        "text changed",
+			contentType: nacos.ConfigurationTypeText,
+			a:           "line 1\nline 2",
+			b:           "line 1\nline  2",
+			equivalent:  false,
+		},
+		{
+			name:        "json whitespace and key order",
+			contentType: nacos.ConfigurationTypeJSON,
+			a:           `{"a": 1, "b": [1, 2], "c": {"d": 1.50}}`,
+			b:           "{\n  \"c\": {\"d\": 1.50},\n  \"b\": [1,2],\n  \"a\": 1\n}\n",
+			equivalent:  true,
+		},
+		{
+			name:        "json changed",
+			contentType: nacos.ConfigurationTypeJSON,
+			a:           `{"a": 1, "b": [1, 2]}`,
+			b:           `{"a": 1, "b": [2, 1]}`,
+			equivalent:  false,
+		},
+		{
+			name:        "invalid json",
+			contentType: nacos.ConfigurationTypeJSON,
+			a:           `{"a": 1}`,
+			b:           `{"a": 1`,
+			equivalent:  false,
+		},
+		{
+			name:        "yaml indentation and key order",
+			contentType: nacos.ConfigurationTypeYAML,
+			a:           "a: 1\nb:\n  - x\n  - y\nc:\n    d: true\n",
+			b:           "c: {d: true}\r\nb: [x, y]\r\na: 1\r\n",
+			equivalent:  true,
+		},
+		{
+			name:        "yaml changed",
+			contentType: nacos.ConfigurationTypeYAML,
+			a:           "a: 1",
+			b:           "a: 2",
+			equivalent:  false,
+		},
+		{
+			name:        "yaml documents reformatted",
+			contentType: nacos.ConfigurationTypeYAML,
+			a:           "a: 1\n---\nspring:\n  profiles: prod\nb: [x]\n",
+			b:           "a: 1\n---\nb:\n  - x\nspring: {profiles: prod}\n",
+			equivalent:  true,
+		},
+		{
+			name:        "yaml second document changed",
+			contentType: nacos.ConfigurationTypeYAML,
+			a:           "a: 1\n---\nspring:\n  profiles: prod\nb: 1\n",
+			b:           "a: 1\n---\nspring:\n  profiles: prod\nb: 2\n",
+			equivalent:  false,
+		},
+		{
+			name:        "yaml document added",
+			contentType: nacos.ConfigurationTypeYAML,
+			a:           "a: 1\n",
+			b:           "a: 1\n---\nb: 2\n",
+			equivalent:  false,
+		},
+		{
+			name:        "properties separators, comments and order",
+			contentType: nacos.ConfigurationTypeProperties,
+			a:           "# comment\na=1\nb = 2\nc: 3\nlong=one \\\n    two\n",
+			b:           "c 3\r\n! other comment\r\nb=2\r\na = 1\r\nlong = one two\r\n",
+			equivalent:  true,
+		},
+		{
+			name:        "properties changed",
+			contentType: nacos.ConfigurationTypeProperties,
+			a:           "a=1\nb=2",
+			b:           "a=1\nb=3",
+			equivalent:  false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.equivalent, contentEquivalent(tc.contentType, tc.a, tc.b))
+		})
+	}
+}
+
+func TestRenderContentObject(t *testing.T) {
+	object := `{"server": {"port": 8080, "ratio": 0.5, "host": "a b"}, "features": ["x", "y"], "debug": false, "note": null}`
+
+	testcases := []struct {
+		contentType string
+		expect      string
+		err         bool
+	}{
+		{
+			contentType: nacos.ConfigurationTypeJSON,
+			expect: `{
+  "debug": false,
+  "features": [
+    "x",
+    "y"
+  ],
+  "note": null,
+  "server": {
+    "host": "a b",
+    "port": 8080,
+    "ratio": 0.5
+  }
+}`,
+		},
+		{
+			contentType: nacos.ConfigurationTypeYAML,
+			expect: `debug: false
+features:
+  - x
+  - "y"
+note: null
+server:
+  host: a b
+  port: 8080
+  ratio: 0.5`,
+		},
+		{
+			contentType: nacos.ConfigurationTypeProperties,
+			expect: `debug=false
+features[0]=x
+features[1]=y
+note=
+server.host=a b
+server.port=8080
+server.ratio=0.5`,
+		},
+		{
+			contentType: nacos.ConfigurationTypeText,
+			err:         true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.contentType, func(t *testing.T) {
+			content, err := renderContentObject(tc.contentType, object)
+			if tc.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, content)
+
+			// structurally equal to the object it was rendered from
+			remoteObject, err := contentToObject(tc.contentType, content)
+			assert.Nil(t, err)
+			rendered, err := renderContentObject(tc.contentType, remoteObject)
+			assert.Nil(t, err)
+			assert.True(t, contentEquivalent(tc.contentType, content, rendered))
+		})
+	}
+
+	_, err := renderContentObject(nacos.ConfigurationTypeJSON, "{")
+	assert.NotNil(t, err)
+
+	_, err = contentToObject(nacos.ConfigurationTypeYAML, "a: 1\n---\nb: 2\n")
+	assert.NotNil(t, err)
+}
+
+func TestRenderSourceFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "application.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte("url: ${host}:${port}\nplaceholder: $${spring.value}\n"), 0644))
+
+	content, err := renderSourceFile(path, map[string]interface{}{"host": "localhost", "port": "8080"})
+	assert.Nil(t, err)
+	assert.Equal(t, "url: localhost:8080\nplaceholder: ${spring.value}\n", content)
+
+	// without vars, the file is used as is
+	content, err = renderSourceFile(path, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "url: ${host}:${port}\nplaceholder: $${spring.value}\n", content)
+
+	_, err = renderSourceFile(path, map[string]interface{}{"host": "localhost"})
+	assert.NotNil(t, err)
+
+	// the functions of templatefile() are available
+	functionsPath := filepath.Join(t.TempDir(), "application.json")
+	assert.Nil(t, os.WriteFile(functionsPath, []byte(`{"name": "${upper(name)}", "hosts": ${jsonencode(split(",", hosts))}}`), 0644))
+	content, err = renderSourceFile(functionsPath, map[string]interface{}{"name": "app", "hosts": "a,b"})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name": "APP", "hosts": ["a","b"]}`, content)
+
+	_, err = renderSourceFile(filepath.Join(t.TempDir(), "missing.yaml"), nil)
+	assert.NotNil(t, err)
+}
+
+func TestInferContentType(t *testing.T) {
+	for path, contentType := range map[string]string{
+		"config/app.json":        nacos.ConfigurationTypeJSON,
+		"app.YAML":               nacos.ConfigurationTypeYAML,
+		"app.yml":                nacos.ConfigurationTypeYAML,
+		"application.properties": nacos.ConfigurationTypeProperties,
+		"logback.xml":            nacos.ConfigurationTypeXML,
+		"index.htm":              nacos.ConfigurationTypeHTML,
+		"README":                 nacos.ConfigurationTypeText,
+		"notes.txt":              nacos.ConfigurationTypeText,
+	} {
+		assert.Equal(t, contentType, inferContentType(path), path)
+	}
+}
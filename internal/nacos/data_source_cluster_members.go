@@ -0,0 +1,151 @@
+package nacos
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func dataSourceClusterMembers() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fail_access_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"raft_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"leader": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"members": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"term": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"member_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"up_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			// whether all the members are UP
+			"all_up": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+
+		ReadContext: dataSourceClusterMembersRead,
+	}
+}
+
+func dataSourceClusterMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	members, err := client.ListClusterMembers(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	upCount := 0
+	flattened := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		if member.State == nacos.MemberStateUp {
+			upCount++
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"address":           member.Address,
+			"ip":                member.Ip,
+			"port":              member.Port,
+			"state":             member.State,
+			"version":           member.ExtendInfo.Version,
+			"fail_access_count": member.FailAccessCount,
+			"raft_groups":       flattenRaftGroups(member.ExtendInfo.RaftMetadata.Groups),
+		})
+	}
+
+	for k, v := range map[string]interface{}{
+		"members":      flattened,
+		"member_count": len(members),
+		"up_count":     upCount,
+		"all_up":       len(members) > 0 && upCount == len(members),
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("cluster_members")
+
+	return nil
+}
+
+// flattenRaftGroups sorts the raft groups by name
+func flattenRaftGroups(groups map[string]nacos.RaftGroup) []interface{} {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]interface{}, 0, len(groups))
+	for _, name := range names {
+		group := groups[name]
+		result = append(result, map[string]interface{}{
+			"name":    name,
+			"leader":  group.Leader,
+			"members": group.Members,
+			"term":    int(group.Term),
+		})
+	}
+	return result
+}
@@ -0,0 +1,23 @@
+package nacos
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNacosClusterMembers_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "nacos_cluster_members" "sample" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nacos_cluster_members.sample", "members.0.address"),
+					resource.TestCheckResourceAttr("data.nacos_cluster_members.sample", "all_up", "true"),
+				),
+			},
+		},
+	})
+}
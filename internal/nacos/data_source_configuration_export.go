@@ -0,0 +1,149 @@
+package nacos
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func dataSourceConfigurationExport() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNamespace,
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateGroup,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      nacos.ExportFormatV2,
+				ValidateFunc: validation.StringInSlice([]string{nacos.ExportFormatV1, nacos.ExportFormatV2}, false),
+			},
+			"output_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"app_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+
+		ReadContext: dataSourceConfigurationExportRead,
+	}
+}
+
+func dataSourceConfigurationExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	var ids []string
+	for _, id := range d.Get("ids").([]interface{}) {
+		ids = append(ids, id.(string))
+	}
+	params := &nacos.ExportConfigurationsParams{
+		Namespace: d.Get("namespace").(string),
+		Group:     d.Get("group").(string),
+		Ids:       ids,
+		Format:    d.Get("format").(string),
+	}
+	archive, err := client.ExportConfigurations(ctx, params)
+	if err != nil {
+		return diag.Errorf("failed to export configurations = %+v: %v", *params, err)
+	}
+
+	archiveItems, err := nacos.ParseConfigurationArchive(archive)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	items := make([]interface{}, 0, len(archiveItems))
+	for _, item := range archiveItems {
+		items = append(items, map[string]interface{}{
+			"group":       item.Group,
+			"key":         item.Key,
+			"type":        item.Type,
+			"description": item.Description,
+			"app_name":    item.AppName,
+			"size":        item.Size,
+		})
+	}
+
+	// keep the archive out of the state when it is written to a file
+	contentBase64 := ""
+	if outputPath := d.Get("output_path").(string); outputPath != "" {
+		if err := os.WriteFile(outputPath, archive, 0644); err != nil {
+			return diag.Errorf("failed to write archive to %s: %v", outputPath, err)
+		}
+	} else {
+		contentBase64 = base64.StdEncoding.EncodeToString(archive)
+	}
+
+	sum := sha256.Sum256(archive)
+	checksum := hex.EncodeToString(sum[:])
+	for k, v := range map[string]interface{}{
+		"content_base64": contentBase64,
+		"sha256":         checksum,
+		"items":          items,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(checksum)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package nacos
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func TestAccNacosConfigurationExport_basic(t *testing.T) {
+	rKey := fmt.Sprintf("config-key-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosConfigurationExportConfig(rKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nacos_configuration_export.sample", "content_base64"),
+					resource.TestCheckResourceAttrSet("data.nacos_configuration_export.sample", "sha256"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.nacos_configuration_export.sample", "items.*", map[string]string{
+						"group": _group1,
+						"key":   rKey,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccNacosConfigurationExportConfig(rKey string) string {
+	return testAccNacosConfigurationConfig(rKey, nacos.Configuration{}) + fmt.Sprintf(`
+	data "nacos_configuration_export" "sample" {
+		namespace = "%s"
+		group = "%s"
+
+		depends_on = [nacos_configuration.sample]
+	}
+	`, _namespace1, _group1)
+}
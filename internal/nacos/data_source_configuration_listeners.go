@@ -0,0 +1,112 @@
+package nacos
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func dataSourceConfigurationListeners() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNamespace,
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      nacos.DefaultGroup,
+				ValidateFunc: validateGroup,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateKey,
+			},
+			// the MD5 of the content on the server, which converged listeners report
+			"md5": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"listeners": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"md5": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"converged": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"listener_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"converged": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+
+		ReadContext: dataSourceConfigurationListenersRead,
+	}
+}
+
+func dataSourceConfigurationListenersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	configurationId := &nacos.ConfigurationId{
+		Namespace: d.Get("namespace").(string),
+		Group:     d.Get("group").(string),
+		Key:       d.Get("key").(string),
+	}
+	configuration, err := client.GetConfiguration(ctx, configurationId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	listeners, err := client.GetConfigurationListeners(ctx, configurationId)
+	if err != nil {
+		return diag.Errorf("failed to get listeners of configuration = %+v: %v", *configurationId, err)
+	}
+
+	contentMD5 := configurationMD5(configuration, true)
+	converged := true
+	items := make([]interface{}, 0, len(listeners))
+	for _, listener := range listeners {
+		converged = converged && listener.MD5 == contentMD5
+		items = append(items, map[string]interface{}{
+			"ip":        listener.Ip,
+			"md5":       listener.MD5,
+			"converged": listener.MD5 == contentMD5,
+		})
+	}
+
+	for k, v := range map[string]interface{}{
+		"md5":            contentMD5,
+		"listeners":      items,
+		"listener_count": len(listeners),
+		"converged":      converged,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(convToResourceId(configurationId.Namespace, configurationId.Group, configurationId.Key))
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package nacos
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func TestAccNacosConfigurationListeners_basic(t *testing.T) {
+	rKey := fmt.Sprintf("config-key-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosConfigurationListenersConfig(rKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.nacos_configuration_listeners.sample", "md5", "nacos_configuration.sample", "md5"),
+					resource.TestCheckResourceAttr("data.nacos_configuration_listeners.sample", "listener_count", "0"),
+					resource.TestCheckResourceAttr("data.nacos_configuration_listeners.sample", "converged", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNacosConfigurationListenersConfig(rKey string) string {
+	return testAccNacosConfigurationConfig(rKey, nacos.Configuration{}) + fmt.Sprintf(`
+	data "nacos_configuration_listeners" "sample" {
+		namespace = "%s"
+		group = "%s"
+		key = "%s"
+
+		depends_on = [nacos_configuration.sample]
+	}
+	`, _namespace1, _group1, rKey)
+}
@@ -0,0 +1,105 @@
+package nacos
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+// dataSourceListenedConfigurations lists the configurations a client IP listens to, the reverse of nacos_configuration_listeners
+func dataSourceListenedConfigurations() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"namespace": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validateNamespace,
+				ConflictsWith: []string{"all_namespaces"},
+			},
+			"all_namespaces": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"md5": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"configuration_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		ReadContext: dataSourceListenedConfigurationsRead,
+	}
+}
+
+func dataSourceListenedConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	params := &nacos.ListenedConfigurationsParams{
+		Ip:        d.Get("ip").(string),
+		Namespace: d.Get("namespace").(string),
+		All:       d.Get("all_namespaces").(bool),
+	}
+	configurations, err := client.GetListenedConfigurations(ctx, params)
+	if err != nil {
+		return diag.Errorf("failed to get configurations listened by ip = %s: %v", params.Ip, err)
+	}
+
+	items := make([]interface{}, 0, len(configurations))
+	for _, configuration := range configurations {
+		items = append(items, map[string]interface{}{
+			"namespace": configuration.Namespace,
+			"group":     configuration.Group,
+			"key":       configuration.Key,
+			"md5":       configuration.MD5,
+		})
+	}
+
+	for k, v := range map[string]interface{}{
+		"configurations":      items,
+		"configuration_count": len(configurations),
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if params.All {
+		d.SetId(params.Ip)
+	} else {
+		d.SetId(joinResourceId(namespaceIdPart(params.Namespace), params.Ip))
+	}
+
+	return nil
+}
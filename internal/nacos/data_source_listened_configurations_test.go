@@ -0,0 +1,41 @@
+package nacos
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNacosListenedConfigurations_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			// no client listens from this address
+			{
+				Config: fmt.Sprintf(`
+				data "nacos_listened_configurations" "sample" {
+					ip = "192.0.2.1"
+					namespace = "%s"
+				}
+				`, _namespace1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nacos_listened_configurations.sample", "configuration_count", "0"),
+					resource.TestCheckResourceAttr("data.nacos_listened_configurations.sample", "configurations.#", "0"),
+				),
+			},
+			{
+				Config: `
+				data "nacos_listened_configurations" "sample" {
+					ip = "192.0.2.1"
+					all_namespaces = true
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nacos_listened_configurations.sample", "configuration_count", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+package nacos
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func dataSourceNamingMetrics() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instance_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"subscribe_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"responsible_service_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"responsible_instance_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"client_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"responsible_client_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		ReadContext: dataSourceNamingMetricsRead,
+	}
+}
+
+func dataSourceNamingMetricsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	metrics, err := client.GetMetrics(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for k, v := range map[string]interface{}{
+		"status":                     metrics.Status,
+		"service_count":              metrics.ServiceCount,
+		"instance_count":             metrics.InstanceCount,
+		"subscribe_count":            metrics.SubscribeCount,
+		"responsible_service_count":  metrics.ResponsibleServiceCount,
+		"responsible_instance_count": metrics.ResponsibleInstanceCount,
+		"client_count":               metrics.ClientCount,
+		"responsible_client_count":   metrics.ResponsibleClientCount,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("naming_metrics")
+
+	return nil
+}
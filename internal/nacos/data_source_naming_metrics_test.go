@@ -0,0 +1,23 @@
+package nacos
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNacosNamingMetrics_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "nacos_naming_metrics" "sample" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nacos_naming_metrics.sample", "status", "UP"),
+					resource.TestCheckResourceAttrSet("data.nacos_naming_metrics.sample", "service_count"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,58 @@
+package nacos
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func dataSourceRaftLeader() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"term": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"vote_for": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		ReadContext: dataSourceRaftLeaderRead,
+	}
+}
+
+func dataSourceRaftLeaderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	leader, err := client.GetRaftLeader(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for k, v := range map[string]interface{}{
+		"ip":       leader.Ip,
+		"state":    leader.State,
+		"term":     int(leader.Term),
+		"vote_for": leader.VoteFor,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("raft_leader")
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package nacos
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNacosRaftLeader_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "nacos_raft_leader" "sample" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nacos_raft_leader.sample", "state", "LEADER"),
+					resource.TestCheckResourceAttrSet("data.nacos_raft_leader.sample", "ip"),
+					resource.TestCheckResourceAttrSet("data.nacos_raft_leader.sample", "term"),
+				),
+			},
+		},
+	})
+}
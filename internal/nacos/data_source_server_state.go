@@ -0,0 +1,75 @@
+package nacos
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func dataSourceServerState() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ready": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			// the reason why the server is not ready
+			"readiness_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"standalone_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// all the entries of the server state
+			"state": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		ReadContext: dataSourceServerStateRead,
+	}
+}
+
+func dataSourceServerStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	readiness, err := client.GetReadiness(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	state, err := client.GetServerState(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for k, v := range map[string]interface{}{
+		"ready":             readiness.Ready,
+		"readiness_message": readiness.Message,
+		"version":           state.Version,
+		"standalone_mode":   state.StandaloneMode,
+		"function_mode":     state.FunctionMode,
+		"state":             state.Entries,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("server_state")
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package nacos
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func dataSourceServiceInstances() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNamespace,
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      nacos.DefaultGroup,
+				ValidateFunc: validateGroup,
+			},
+			"service": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateServiceName,
+			},
+			"clusters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateClusterName,
+				},
+			},
+			"healthy_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"healthy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ephemeral": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"metadata": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+
+		ReadContext: dataSourceServiceInstancesRead,
+	}
+}
+
+func dataSourceServiceInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	params := &nacos.ListInstancesParams{
+		Namespace:   d.Get("namespace").(string),
+		Group:       d.Get("group").(string),
+		Service:     d.Get("service").(string),
+		HealthyOnly: d.Get("healthy_only").(bool),
+	}
+	for _, cluster := range d.Get("clusters").([]interface{}) {
+		params.Clusters = append(params.Clusters, cluster.(string))
+	}
+	instances, err := client.ListInstances(ctx, params)
+	if err != nil {
+		return diag.Errorf("failed to list instances = %+v: %v", *params, err)
+	}
+
+	items := make([]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		items = append(items, map[string]interface{}{
+			"instance_id": instance.InstanceId,
+			"cluster":     instance.Cluster,
+			"ip":          instance.Ip,
+			"port":        instance.Port,
+			"weight":      instance.Weight,
+			"enabled":     instance.Enabled,
+			"healthy":     instance.Healthy,
+			"ephemeral":   instance.Ephemeral,
+			"metadata":    instance.Metadata,
+		})
+	}
+
+	if err := d.Set("instances", items); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(convToResourceId(params.Namespace, params.Group, params.Service))
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package nacos
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNacosServiceInstances_basic(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosInstanceConfig(rName, 1, "a") + `
+				data "nacos_service_instances" "sample" {
+					namespace = nacos_instance.sample.namespace
+					group = nacos_instance.sample.group
+					service = nacos_instance.sample.service
+					clusters = ["DEFAULT"]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nacos_service_instances.sample", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.nacos_service_instances.sample", "instances.0.ip", "10.0.0.1"),
+					resource.TestCheckResourceAttr("data.nacos_service_instances.sample", "instances.0.port", "3306"),
+					resource.TestCheckResourceAttr("data.nacos_service_instances.sample", "instances.0.metadata.zone", "a"),
+				),
+			},
+		},
+	})
+}
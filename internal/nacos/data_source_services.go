@@ -0,0 +1,55 @@
+package nacos
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func dataSourceServices() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNamespace,
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      nacos.DefaultGroup,
+				ValidateFunc: validateGroup,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		ReadContext: dataSourceServicesRead,
+	}
+}
+
+func dataSourceServicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	params := &nacos.SearchServicesParams{
+		Namespace: d.Get("namespace").(string),
+		Group:     d.Get("group").(string),
+	}
+	names, err := client.ListServices(ctx, params)
+	if err != nil {
+		return diag.Errorf("failed to list services = %+v: %v", *params, err)
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(convToGroupResourceId(params.Namespace, params.Group))
+
+	return nil
+}
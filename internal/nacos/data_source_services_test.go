@@ -0,0 +1,34 @@
+package nacos
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccNacosServices_basic(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosServiceConfig(rName, 0, "team-a") + fmt.Sprintf(`
+				data "nacos_services" "sample" {
+					namespace = "%s"
+					group = "%s"
+
+					depends_on = [nacos_service.sample]
+				}
+				`, _namespace1, _group1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.nacos_services.sample", "names.*", rName),
+				),
+			},
+		},
+	})
+}
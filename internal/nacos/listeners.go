@@ -0,0 +1,123 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+const (
+	onTimeoutError = "error"
+	onTimeoutWarn  = "warn"
+)
+
+func waitForListenersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min_listeners": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"timeout": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "5m",
+					ValidateFunc: validateDuration,
+				},
+				"require_md5_match": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"on_timeout": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      onTimeoutError,
+					ValidateFunc: validation.StringInSlice([]string{onTimeoutError, onTimeoutWarn}, false),
+				},
+			},
+		},
+	}
+}
+
+// waitForListeners polls the listeners of a published configuration until they converge as configured by `wait_for_listeners`.
+// A timeout is only a warning when the configuration was just created, see listenersTimeoutSeverity
+func waitForListeners(ctx context.Context, client *nacos.Client, d *schema.ResourceData, configurationId *nacos.ConfigurationId, created bool) diag.Diagnostics {
+	raw := d.Get("wait_for_listeners").([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	wait := raw[0].(map[string]interface{})
+	timeout, _ := time.ParseDuration(wait["timeout"].(string))
+	minListeners := wait["min_listeners"].(int)
+	requireMD5Match := wait["require_md5_match"].(bool)
+
+	configuration, err := client.GetConfiguration(ctx, configurationId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	contentMD5 := configurationMD5(configuration, true)
+
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		listeners, err := client.GetConfigurationListeners(ctx, configurationId)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if err := listenersConverged(listeners, minListeners, contentMD5, requireMD5Match); err != nil {
+			return resource.RetryableError(err)
+		}
+		return nil
+	})
+	if err == nil {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: listenersTimeoutSeverity(wait["on_timeout"].(string), created),
+		Summary:  fmt.Sprintf("listeners of configuration = %+v did not converge in %s", *configurationId, timeout),
+		Detail:   err.Error(),
+	}}
+}
+
+// listenersTimeoutSeverity follows on_timeout, except for a created configuration: failing its creation would taint it,
+// and replacing it on the next apply would notify its listeners of a deletion
+func listenersTimeoutSeverity(onTimeout string, created bool) diag.Severity {
+	if created || onTimeout == onTimeoutWarn {
+		return diag.Warning
+	}
+	return diag.Error
+}
+
+// listenersConverged checks that there are at least minListeners, which all received the content of contentMD5 when required
+func listenersConverged(listeners []nacos.ConfigurationListener, minListeners int, contentMD5 string, requireMD5Match bool) error {
+	if len(listeners) < minListeners {
+		return fmt.Errorf("%d listeners, waiting for at least %d", len(listeners), minListeners)
+	}
+	if !requireMD5Match {
+		return nil
+	}
+
+	var stale []string
+	for _, listener := range listeners {
+		if listener.MD5 != contentMD5 {
+			stale = append(stale, listener.Ip)
+		}
+	}
+	if len(stale) > 0 {
+		return fmt.Errorf("listeners %v have not received md5 = %s yet", stale, contentMD5)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package nacos
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func TestListenersConverged(t *testing.T) {
+	listeners := []nacos.ConfigurationListener{
+		{Ip: "10.0.0.1", MD5: "new"},
+		{Ip: "10.0.0.2", MD5: "old"},
+	}
+
+	testcases := []struct {
+		name            string
+		listeners       []nacos.ConfigurationListener
+		minListeners    int
+		requireMD5Match bool
+		expectErr       bool
+	}{
+		{name: "no listener", minListeners: 0, requireMD5Match: true},
+		{name: "not enough listeners", listeners: listeners, minListeners: 3, expectErr: true},
+		{name: "stale listener", listeners: listeners, minListeners: 1, requireMD5Match: true, expectErr: true},
+		{name: "md5 not required", listeners: listeners, minListeners: 2},
+		{name: "converged", listeners: listeners[:1], minListeners: 1, requireMD5Match: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := listenersConverged(tc.listeners, tc.minListeners, "new", tc.requireMD5Match)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestListenersTimeoutSeverity(t *testing.T) {
+	assert.Equal(t, diag.Error, listenersTimeoutSeverity(onTimeoutError, false))
+	assert.Equal(t, diag.Warning, listenersTimeoutSeverity(onTimeoutWarn, false))
+	// a created configuration is not tainted
+	assert.Equal(t, diag.Warning, listenersTimeoutSeverity(onTimeoutError, true))
+	assert.Equal(t, diag.Warning, listenersTimeoutSeverity(onTimeoutWarn, true))
+}
@@ -31,10 +31,36 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NACOS_CONTEXT_PATH", "nacos"),
 			},
+			"encryption_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("NACOS_ENCRYPTION_KEY", nil),
+			},
 		},
 		ConfigureContextFunc: providerConfigure,
 		ResourcesMap: map[string]*schema.Resource{
-			"nacos_configuration": resourceConfiguration(),
+			"nacos_configuration":        resourceConfiguration(),
+			"nacos_configuration_import": resourceConfigurationImport(),
+			"nacos_configuration_clone":  resourceConfigurationClone(),
+			"nacos_configurations":       resourceConfigurations(),
+			"nacos_service":              resourceService(),
+			"nacos_instance":             resourceInstance(),
+			"nacos_service_cluster":      resourceServiceCluster(),
+			"nacos_instance_metadata":    resourceInstanceMetadata(),
+			"nacos_instance_maintenance": resourceInstanceMaintenance(),
+			"nacos_naming_switches":      resourceNamingSwitches(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"nacos_configuration_export":    dataSourceConfigurationExport(),
+			"nacos_configuration_listeners": dataSourceConfigurationListeners(),
+			"nacos_listened_configurations": dataSourceListenedConfigurations(),
+			"nacos_services":                dataSourceServices(),
+			"nacos_service_instances":       dataSourceServiceInstances(),
+			"nacos_cluster_members":         dataSourceClusterMembers(),
+			"nacos_raft_leader":             dataSourceRaftLeader(),
+			"nacos_server_state":            dataSourceServerState(),
+			"nacos_naming_metrics":          dataSourceNamingMetrics(),
 		},
 	}
 }
@@ -42,11 +68,21 @@ func Provider() *schema.Provider {
 func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	var encryptors []nacos.Encryptor
+	if key := d.Get("encryption_key").(string); key != "" {
+		encryptor, err := nacos.NewAESEncryptor(key)
+		if err != nil {
+			return nil, diag.Errorf("create encryptor error: %v", err)
+		}
+		encryptors = append(encryptors, encryptor)
+	}
+
 	c, err := nacos.NewClient(&nacos.Config{
 		Username:    d.Get("username").(string),
 		Password:    d.Get("password").(string),
 		Address:     d.Get("address").(string),
 		ContextPath: d.Get("context_path").(string),
+		Encryptors:  encryptors,
 	})
 
 	if err != nil {
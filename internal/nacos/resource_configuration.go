@@ -2,9 +2,15 @@ package nacos
 
 import (
 	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
 )
@@ -13,27 +19,95 @@ func resourceConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"key": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKey,
 			},
 			"namespace": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "",
+				ValidateFunc:     validateNamespace,
+				DiffSuppressFunc: suppressEquivalentNamespace,
 			},
 			"group": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nacos.DefaultGroup,
+				ValidateFunc: validateGroup,
 			},
 			"value": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     configurationValueKeys,
+				ValidateFunc:     validateContent,
+				DiffSuppressFunc: suppressEquivalentContent,
+			},
+			"sensitive_value": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ExactlyOneOf:     configurationValueKeys,
+				ValidateFunc:     validateContent,
+				DiffSuppressFunc: suppressEquivalentContent,
+			},
+			// only the MD5 of the value is stored in the state, it is compared to the MD5 computed by the server
+			"write_only_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				StateFunc:    hashValue,
+				ExactlyOneOf: configurationValueKeys,
+				ValidateFunc: validateContent,
+			},
+			// a JSON encoded object, rendered according to `type`
+			"content_object": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     configurationValueKeys,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+			},
+			"source_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: configurationValueKeys,
+			},
+			"template_vars": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_file"},
+			},
+			// the SHA256 of the rendered source_file, or of the content on the server when it drifted
+			"source_hash": {
 				Type:     schema.TypeString,
-				Required: true,
+				Computed: true,
 			},
-			"description": {
+			"md5": {
 				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDescription,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(nacos.ConfigurationTypes, false),
+			},
+			"wait_for_listeners": waitForListenersSchema(),
+			// disables the comparison of the value according to its type
+			"strict_value_comparison": {
+				Type:     schema.TypeBool,
 				Optional: true,
+				Default:  false,
 			},
 		},
 
@@ -41,27 +115,76 @@ func resourceConfiguration() *schema.Resource {
 		ReadContext:   resourceConfigurationRead,
 		UpdateContext: resourceConfigurationUpdate,
 		DeleteContext: resourceConfigurationDelete,
+		CustomizeDiff: resourceConfigurationCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceConfigurationV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceConfigurationStateUpgradeV0,
+			},
+		},
 	}
 }
 
+var configurationValueKeys = []string{"value", "sensitive_value", "write_only_value", "content_object", "source_file"}
+
+func resourceConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if err := customizeSourceFileDiff(d); err != nil {
+		return err
+	}
+
+	if d.Get("content_object").(string) == "" {
+		return nil
+	}
+	if d.GetRawConfig().GetAttr("type").IsNull() {
+		return fmt.Errorf("type must be set with content_object")
+	}
+	if !d.NewValueKnown("type") || !d.NewValueKnown("content_object") {
+		return nil
+	}
+	content, err := renderContentObject(d.Get("type").(string), d.Get("content_object").(string))
+	if err != nil {
+		return err
+	}
+	if err := checkContent(content); err != nil {
+		return fmt.Errorf("content_object rendered content %v", err)
+	}
+	return nil
+}
+
 func resourceConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*nacos.Client)
 
+	content, err := configurationContent(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	configuration := &nacos.Configuration{
 		Namespace:   d.Get("namespace").(string),
 		Group:       d.Get("group").(string),
 		Key:         d.Get("key").(string),
-		Value:       d.Get("value").(string),
+		Value:       content,
 		Description: d.Get("description").(string),
+		Type:        d.Get("type").(string),
 	}
-	err := client.PublishConfiguration(ctx, configuration)
+	err = client.PublishConfiguration(ctx, configuration)
 	if err != nil {
 		return diag.Errorf("failed to create configuration = %+v: %v", *configuration, err)
 	}
 
 	d.SetId(convToResourceId(configuration.Namespace, configuration.Group, configuration.Key))
 
-	return resourceConfigurationRead(ctx, d, meta)
+	diags := waitForListeners(ctx, client, d, &nacos.ConfigurationId{
+		Namespace: configuration.Namespace,
+		Group:     configuration.Group,
+		Key:       configuration.Key,
+	}, true)
+	return append(diags, resourceConfigurationRead(ctx, d, meta)...)
 }
 
 func resourceConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -77,13 +200,33 @@ func resourceConfigurationRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	for k, v := range map[string]interface{}{
+	contentMD5 := configurationMD5(configuration, false)
+	attributes := map[string]interface{}{
 		"namespace":   configuration.Namespace,
 		"group":       configuration.Group,
 		"key":         configuration.Key,
-		"value":       configuration.Value,
 		"description": configuration.Description,
-	} {
+		"type":        configuration.Type,
+		"md5":         contentMD5,
+	}
+	switch {
+	case d.Get("source_file").(string) != "":
+		attributes["source_hash"] = hashContent(configuration.Value)
+	case d.Get("content_object").(string) != "":
+		object, err := readContentObject(d.Get("content_object").(string), configuration)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		attributes["content_object"] = object
+	case d.Get("write_only_value").(string) != "":
+		attributes["write_only_value"] = contentMD5
+	case d.Get("sensitive_value").(string) != "":
+		attributes["sensitive_value"] = configuration.Value
+	default:
+		attributes["value"] = configuration.Value
+	}
+
+	for k, v := range attributes {
 		if err := d.Set(k, v); err != nil {
 			return diag.FromErr(err)
 		}
@@ -95,21 +238,36 @@ func resourceConfigurationRead(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*nacos.Client)
-	if d.HasChanges("value", "description") {
+	var diags diag.Diagnostics
+	if d.HasChanges("value", "sensitive_value", "write_only_value", "content_object", "source_file", "template_vars", "source_hash", "description", "type") {
+		content, err := configurationContent(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		configuration := &nacos.Configuration{
 			Namespace:   d.Get("namespace").(string),
 			Group:       d.Get("group").(string),
 			Key:         d.Get("key").(string),
-			Value:       d.Get("value").(string),
+			Value:       content,
 			Description: d.Get("description").(string),
+			Type:        d.Get("type").(string),
 		}
-		err := client.PublishConfiguration(ctx, configuration)
+		err = client.PublishConfiguration(ctx, configuration)
 		if err != nil {
 			return diag.Errorf("failed to update configuration = %+v: %v", *configuration, err)
 		}
+
+		diags = waitForListeners(ctx, client, d, &nacos.ConfigurationId{
+			Namespace: configuration.Namespace,
+			Group:     configuration.Group,
+			Key:       configuration.Key,
+		}, false)
+		if diags.HasError() {
+			return diags
+		}
 	}
 
-	return resourceConfigurationRead(ctx, d, meta)
+	return append(diags, resourceConfigurationRead(ctx, d, meta)...)
 }
 
 func resourceConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -129,3 +287,87 @@ func resourceConfigurationDelete(ctx context.Context, d *schema.ResourceData, me
 
 	return nil
 }
+
+// customizeSourceFileDiff plans an update when the rendered file changes and infers `type` from its extension
+func customizeSourceFileDiff(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("source_file") || !d.NewValueKnown("template_vars") {
+		return d.SetNewComputed("source_hash")
+	}
+	sourceFile := d.Get("source_file").(string)
+	if sourceFile == "" {
+		return nil
+	}
+
+	content, err := renderSourceFile(sourceFile, d.Get("template_vars").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	if err := checkContent(content); err != nil {
+		return fmt.Errorf("source_file content %v", err)
+	}
+	if hash := hashContent(content); hash != d.Get("source_hash").(string) {
+		if err := d.SetNew("source_hash", hash); err != nil {
+			return err
+		}
+	}
+
+	if d.GetRawConfig().GetAttr("type").IsNull() {
+		if contentType := inferContentType(sourceFile); contentType != d.Get("type").(string) {
+			return d.SetNew("type", contentType)
+		}
+	}
+	return nil
+}
+
+// configurationContent returns the content from whichever of the value attributes is set
+func configurationContent(d resourceGetter) (string, error) {
+	if object := d.Get("content_object").(string); object != "" {
+		return renderContentObject(d.Get("type").(string), object)
+	}
+	if sourceFile := d.Get("source_file").(string); sourceFile != "" {
+		return renderSourceFile(sourceFile, d.Get("template_vars").(map[string]interface{}))
+	}
+
+	for _, k := range configurationValueKeys {
+		if v := d.Get(k).(string); v != "" {
+			return v, nil
+		}
+	}
+	return "", nil
+}
+
+// readContentObject keeps the object of the state while it is structurally equal to the content on the server
+func readContentObject(object string, configuration *nacos.Configuration) (string, error) {
+	rendered, err := renderContentObject(configuration.Type, object)
+	if err == nil && contentEquivalent(configuration.Type, rendered, configuration.Value) {
+		return object, nil
+	}
+
+	remoteObject, err := contentToObject(configuration.Type, configuration.Value)
+	if err != nil {
+		// not representable as an object, surface the raw content as a JSON string
+		raw, err := json.Marshal(configuration.Value)
+		return string(raw), err
+	}
+	return remoteObject, nil
+}
+
+// configurationMD5 prefers the MD5 computed by the server on the stored content.
+// When the content was decrypted by the client, that MD5 is the one of the encrypted content:
+// it is kept when stored is set, e.g. to compare it with the MD5 reported by listeners
+func configurationMD5(configuration *nacos.Configuration, stored bool) string {
+	if configuration.MD5 != "" && (stored || configuration.EncryptedDataKey == "") {
+		return configuration.MD5
+	}
+	return hashValue(configuration.Value)
+}
+
+func hashContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func hashValue(v interface{}) string {
+	sum := md5.Sum([]byte(v.(string)))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,276 @@
+package nacos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+const (
+	cloneActionCreate    = "create"
+	cloneActionSkip      = "skip"
+	cloneActionOverwrite = "overwrite"
+	cloneActionAbort     = "abort"
+)
+
+// resourceGetter is implemented by both schema.ResourceData and schema.ResourceDiff
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
+func resourceConfigurationClone() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"source_namespace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateNamespace,
+				DiffSuppressFunc: suppressEquivalentNamespace,
+			},
+			"target_namespace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateNamespace,
+				DiffSuppressFunc: suppressEquivalentNamespace,
+			},
+			"ids": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"ids", "group"},
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"ids", "group"},
+				ValidateFunc: validateGroup,
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nacos.PolicyAbort,
+				ValidateFunc: validation.StringInSlice(conflictPolicies, false),
+			},
+			"planned_items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"success_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"skip_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fail_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"failed_items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CreateContext: resourceConfigurationCloneCreate,
+		ReadContext:   resourceConfigurationCloneRead,
+		DeleteContext: resourceConfigurationCloneDelete,
+		CustomizeDiff: resourceConfigurationCloneCustomizeDiff,
+	}
+}
+
+// resourceConfigurationCloneCustomizeDiff shows which items will be created, skipped or overwritten in the target namespace
+func resourceConfigurationCloneCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("source_namespace", "target_namespace", "ids", "group", "policy") {
+		return nil
+	}
+	for _, k := range []string{"source_namespace", "target_namespace", "ids", "group"} {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("planned_items")
+		}
+	}
+
+	client := meta.(*nacos.Client)
+	sourceItems, err := resolveCloneItems(ctx, client, d)
+	if err != nil {
+		return err
+	}
+	planned, err := planCloneItems(ctx, client, d, sourceItems)
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("planned_items", planned)
+}
+
+func resourceConfigurationCloneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	sourceItems, err := resolveCloneItems(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(sourceItems) == 0 {
+		return diag.Errorf("no configuration to clone from namespace = %s", d.Get("source_namespace").(string))
+	}
+
+	params := &nacos.CloneConfigurationsParams{
+		TargetNamespace: d.Get("target_namespace").(string),
+		Policy:          d.Get("policy").(string),
+	}
+	for _, item := range sourceItems {
+		params.Items = append(params.Items, nacos.CloneItem{
+			Id:    item.Id.String(),
+			Group: item.Group,
+			Key:   item.Key,
+		})
+	}
+	result, err := client.CloneConfigurations(ctx, params)
+	if err != nil {
+		return diag.Errorf("failed to clone configurations into namespace = %s: %v", params.TargetNamespace, err)
+	}
+
+	for k, v := range map[string]interface{}{
+		"success_count": result.SuccessCount,
+		"skip_count":    result.SkipCount,
+		"fail_count":    len(result.FailData),
+		"failed_items":  batchResultItemIds(result.FailData),
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(resource.UniqueId())
+
+	var diags diag.Diagnostics
+	if len(result.FailData) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%d configurations failed to clone", len(result.FailData)),
+			Detail:   fmt.Sprintf("failed items: %v", batchResultItemIds(result.FailData)),
+		})
+	}
+	return append(diags, resourceConfigurationCloneRead(ctx, d, meta)...)
+}
+
+// resourceConfigurationCloneRead has nothing to refresh, the cloned configurations are not owned by this resource
+func resourceConfigurationCloneRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+// resourceConfigurationCloneDelete only forgets the clone, the cloned configurations are kept on the server
+func resourceConfigurationCloneDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// planCloneItems decides the action taken for each source item, depending on whether it exists in the target namespace.
+// The target group is listed at once, items selected by `ids` are looked up one by one
+func planCloneItems(ctx context.Context, client *nacos.Client, d resourceGetter, sourceItems []nacos.ConfigurationInfo) ([]interface{}, error) {
+	targetNamespace := d.Get("target_namespace").(string)
+	existing := make(map[string]bool, len(sourceItems))
+	if group := d.Get("group").(string); group != "" {
+		targetItems, err := client.ListConfigurations(ctx, &nacos.SearchConfigurationsParams{
+			Namespace: targetNamespace,
+			Group:     group,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list target configurations: %v", err)
+		}
+		for _, item := range targetItems {
+			existing[item.Group+"/"+item.Key] = true
+		}
+	} else {
+		for _, item := range sourceItems {
+			_, err := client.GetConfiguration(ctx, &nacos.ConfigurationId{
+				Namespace: targetNamespace,
+				Group:     item.Group,
+				Key:       item.Key,
+			})
+			if err != nil && !errors.Is(err, nacos.ErrNotFound) {
+				return nil, fmt.Errorf("failed to get target configuration = %s/%s: %v", item.Group, item.Key, err)
+			}
+			existing[item.Group+"/"+item.Key] = err == nil
+		}
+	}
+
+	existsAction := map[string]string{
+		nacos.PolicyAbort:     cloneActionAbort,
+		nacos.PolicySkip:      cloneActionSkip,
+		nacos.PolicyOverwrite: cloneActionOverwrite,
+	}[d.Get("policy").(string)]
+	planned := make([]interface{}, 0, len(sourceItems))
+	for _, item := range sourceItems {
+		action := cloneActionCreate
+		if existing[item.Group+"/"+item.Key] {
+			action = existsAction
+		}
+		planned = append(planned, map[string]interface{}{
+			"group":  item.Group,
+			"key":    item.Key,
+			"action": action,
+		})
+	}
+	return planned, nil
+}
+
+// resolveCloneItems lists the source configurations selected by either `ids` or `group`
+func resolveCloneItems(ctx context.Context, client *nacos.Client, d resourceGetter) ([]nacos.ConfigurationInfo, error) {
+	sourceNamespace := d.Get("source_namespace").(string)
+	items, err := client.ListConfigurations(ctx, &nacos.SearchConfigurationsParams{
+		Namespace: sourceNamespace,
+		Group:     d.Get("group").(string),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list source configurations: %v", err)
+	}
+
+	ids := d.Get("ids").([]interface{})
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	byId := make(map[string]nacos.ConfigurationInfo, len(items))
+	for _, item := range items {
+		byId[item.Id.String()] = item
+	}
+	selected := make([]nacos.ConfigurationInfo, 0, len(ids))
+	for _, id := range ids {
+		item, ok := byId[id.(string)]
+		if !ok {
+			return nil, fmt.Errorf("not found configuration id = %s in namespace = %s", id, sourceNamespace)
+		}
+		selected = append(selected, item)
+	}
+	return selected, nil
+}
@@ -0,0 +1,119 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func TestAccNacosConfigurationClone_basic(t *testing.T) {
+	rKey := fmt.Sprintf("config-key-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	rGroup := fmt.Sprintf("CLONE_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckNacosConfigurationDestroy,
+			testAccCheckNacosConfigurationCloneDestroy(rGroup, rKey),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosConfigurationCloneConfig(rGroup, rKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nacos_configuration_clone.sample", "planned_items.#", "1"),
+					resource.TestCheckResourceAttr("nacos_configuration_clone.sample", "planned_items.0.key", rKey),
+					resource.TestCheckResourceAttr("nacos_configuration_clone.sample", "planned_items.0.action", "create"),
+					resource.TestCheckResourceAttr("nacos_configuration_clone.sample", "success_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNacosConfigurationClone_ids(t *testing.T) {
+	if testNacosClient == nil {
+		t.Skip("the configurations selected by ids are published before the test, which requires TF_ACC")
+	}
+	rKey := fmt.Sprintf("config-key-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	rGroup := fmt.Sprintf("CLONE_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	// the key exists in both namespaces, so that the item is planned to be skipped
+	for _, namespace := range []string{_namespace1, _namespace2} {
+		err := testNacosClient.PublishConfiguration(context.Background(), &nacos.Configuration{
+			Namespace: namespace,
+			Group:     rGroup,
+			Key:       rKey,
+			Value:     _value1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	items, err := testNacosClient.ListConfigurations(context.Background(), &nacos.SearchConfigurationsParams{
+		Namespace: _namespace1,
+		Group:     rGroup,
+	})
+	if err != nil || len(items) != 1 {
+		t.Fatalf("failed to find the source configuration: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckNacosConfigurationCloneDestroy(rGroup, rKey),
+			func(s *terraform.State) error {
+				_, err := testNacosClient.DeleteConfiguration(
+					context.Background(),
+					&nacos.ConfigurationId{Namespace: _namespace1, Group: rGroup, Key: rKey})
+				return err
+			},
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "nacos_configuration_clone" "sample" {
+					source_namespace = "%s"
+					target_namespace = "%s"
+					ids = ["%s"]
+					policy = "SKIP"
+				}
+				`, _namespace1, _namespace2, items[0].Id.String()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nacos_configuration_clone.sample", "planned_items.#", "1"),
+					resource.TestCheckResourceAttr("nacos_configuration_clone.sample", "planned_items.0.key", rKey),
+					resource.TestCheckResourceAttr("nacos_configuration_clone.sample", "planned_items.0.action", "skip"),
+					resource.TestCheckResourceAttr("nacos_configuration_clone.sample", "skip_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNacosConfigurationCloneConfig(group, key string) string {
+	return testAccNacosConfigurationConfig(key, nacos.Configuration{Group: group}) + fmt.Sprintf(`
+	resource "nacos_configuration_clone" "sample" {
+		source_namespace = "%s"
+		target_namespace = "%s"
+		group = nacos_configuration.sample.group
+		policy = "SKIP"
+	}
+	`, _namespace1, _namespace2)
+}
+
+// testAccCheckNacosConfigurationCloneDestroy cleans up, destroying the resource keeps the cloned configurations
+func testAccCheckNacosConfigurationCloneDestroy(group, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := testNacosClient.DeleteConfiguration(
+			context.Background(),
+			&nacos.ConfigurationId{Namespace: _namespace2, Group: group, Key: key})
+		return err
+	}
+}
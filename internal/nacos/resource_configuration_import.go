@@ -0,0 +1,194 @@
+package nacos
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+var conflictPolicies = []string{nacos.PolicyAbort, nacos.PolicySkip, nacos.PolicyOverwrite}
+
+func resourceConfigurationImport() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateNamespace,
+				DiffSuppressFunc: suppressEquivalentNamespace,
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      nacos.PolicyAbort,
+				ValidateFunc: validation.StringInSlice(conflictPolicies, false),
+			},
+			"archive_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"success_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"skip_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fail_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"skipped_items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"failed_items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CreateContext: resourceConfigurationImportCreate,
+		ReadContext:   resourceConfigurationImportRead,
+		UpdateContext: resourceConfigurationImportUpdate,
+		DeleteContext: resourceConfigurationImportDelete,
+		CustomizeDiff: resourceConfigurationImportCustomizeDiff,
+	}
+}
+
+// resourceConfigurationImportCustomizeDiff plans a re-import whenever the content of the archive changes
+func resourceConfigurationImportCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("source") {
+		return d.SetNewComputed("archive_sha256")
+	}
+
+	_, checksum, err := readArchive(d.Get("source").(string))
+	if err != nil {
+		return err
+	}
+	if checksum != d.Get("archive_sha256").(string) {
+		return d.SetNew("archive_sha256", checksum)
+	}
+
+	return nil
+}
+
+func resourceConfigurationImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := importConfigurations(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(resource.UniqueId())
+
+	return append(diags, resourceConfigurationImportRead(ctx, d, meta)...)
+}
+
+// resourceConfigurationImportRead has nothing to refresh, the imported configurations are not owned by this resource
+func resourceConfigurationImportRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceConfigurationImportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if d.HasChanges("source", "archive_sha256") {
+		// keep the previous archive_sha256 when the import fails, so that it is retried by the next apply
+		d.Partial(true)
+		diags = importConfigurations(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+		d.Partial(false)
+	}
+
+	return append(diags, resourceConfigurationImportRead(ctx, d, meta)...)
+}
+
+// resourceConfigurationImportDelete only forgets the import, the imported configurations are kept on the server
+func resourceConfigurationImportDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func importConfigurations(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	source := d.Get("source").(string)
+	archive, checksum, err := readArchive(source)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &nacos.ImportConfigurationsParams{
+		Namespace: d.Get("namespace").(string),
+		Policy:    d.Get("policy").(string),
+		FileName:  filepath.Base(source),
+		Archive:   archive,
+	}
+	result, err := client.ImportConfigurations(ctx, params)
+	if err != nil {
+		return diag.Errorf("failed to import %s into namespace = %s: %v", source, params.Namespace, err)
+	}
+	// Nacos stops at the first conflict with the ABORT policy
+	if params.Policy == nacos.PolicyAbort && len(result.FailData) > 0 {
+		return diag.Errorf("failed to import %s into namespace = %s: aborted on %d conflicting configurations: %v",
+			source, params.Namespace, len(result.FailData), batchResultItemIds(result.FailData))
+	}
+
+	for k, v := range map[string]interface{}{
+		"archive_sha256": checksum,
+		"success_count":  result.SuccessCount,
+		"skip_count":     result.SkipCount,
+		"fail_count":     len(result.FailData),
+		"skipped_items":  batchResultItemIds(result.SkipData),
+		"failed_items":   batchResultItemIds(result.FailData),
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if len(result.FailData) > 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%d configurations failed to import", len(result.FailData)),
+			Detail:   fmt.Sprintf("failed items: %v", batchResultItemIds(result.FailData)),
+		}}
+	}
+	return nil
+}
+
+func readArchive(path string) ([]byte, string, error) {
+	archive, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read archive: %v", err)
+	}
+
+	sum := sha256.Sum256(archive)
+	return archive, hex.EncodeToString(sum[:]), nil
+}
+
+func batchResultItemIds(items []nacos.BatchResultItem) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Group+"/"+item.Key)
+	}
+	return ids
+}
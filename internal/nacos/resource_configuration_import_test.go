@@ -0,0 +1,107 @@
+package nacos
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func TestAccNacosConfigurationImport_basic(t *testing.T) {
+	rKey := fmt.Sprintf("config-key-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	source := filepath.Join(t.TempDir(), "import.zip")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosConfigurationImportDestroy(rKey),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { testAccWriteConfigurationArchive(t, source, rKey, _value1) },
+				Config:    testAccNacosConfigurationImportConfig(source, nacos.PolicyAbort),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nacos_configuration_import.sample", "success_count", "1"),
+					resource.TestCheckResourceAttr("nacos_configuration_import.sample", "fail_count", "0"),
+					testAccCheckNacosConfigurationImported(rKey, _value1),
+				),
+			},
+			// archive changed, re-import
+			{
+				PreConfig: func() { testAccWriteConfigurationArchive(t, source, rKey, _value2) },
+				Config:    testAccNacosConfigurationImportConfig(source, nacos.PolicyOverwrite),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nacos_configuration_import.sample", "success_count", "1"),
+					testAccCheckNacosConfigurationImported(rKey, _value2),
+				),
+			},
+		},
+	})
+}
+
+func testAccNacosConfigurationImportConfig(source, policy string) string {
+	return fmt.Sprintf(`
+	resource "nacos_configuration_import" "sample" {
+		namespace = "%s"
+		source = "%s"
+		policy = "%s"
+	}
+	`, _namespace1, source, policy)
+}
+
+func testAccWriteConfigurationArchive(t *testing.T, path, key, value string) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	for name, content := range map[string]string{
+		_group1 + "/" + key: value,
+		".metadata.yml":     fmt.Sprintf("metadata:\n- dataId: %s\n  group: %s\n  type: text\n", key, _group1),
+	} {
+		entry, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := entry.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckNacosConfigurationImported(key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		configuration, err := testNacosClient.GetConfiguration(
+			context.Background(),
+			&nacos.ConfigurationId{Namespace: _namespace1, Group: _group1, Key: key})
+		if err != nil {
+			return err
+		}
+		if configuration.Value != value {
+			return fmt.Errorf("got value %s, want %s", configuration.Value, value)
+		}
+		return nil
+	}
+}
+
+// testAccCheckNacosConfigurationImportDestroy cleans up, destroying the resource keeps the imported configurations
+func testAccCheckNacosConfigurationImportDestroy(key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := testNacosClient.DeleteConfiguration(
+			context.Background(),
+			&nacos.ConfigurationId{Namespace: _namespace1, Group: _group1, Key: key})
+		return err
+	}
+}
@@ -0,0 +1,56 @@
+package nacos
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceConfigurationV0 is the schema before IDs were built with joinResourceId
+func resourceConfigurationV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceConfigurationStateUpgradeV0 re-encodes the ID from the attributes,
+// the V0 ID spelled the public namespace empty
+func resourceConfigurationStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	namespace, _ := rawState["namespace"].(string)
+	group, hasGroup := rawState["group"].(string)
+	key, hasKey := rawState["key"].(string)
+	if !hasGroup || !hasKey {
+		return rawState, nil
+	}
+
+	rawState["id"] = convToResourceId(namespace, group, key)
+	return rawState, nil
+}
@@ -0,0 +1,46 @@
+package nacos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceConfigurationStateUpgradeV0(t *testing.T) {
+	testcases := []struct {
+		name     string
+		rawState map[string]interface{}
+		expectId string
+	}{
+		{
+			name: "plain",
+			rawState: map[string]interface{}{
+				"id": "sandbox/GROUP/key", "namespace": "sandbox", "group": "GROUP", "key": "key",
+			},
+			expectId: "sandbox/GROUP/key",
+		},
+		{
+			name: "empty public namespace",
+			rawState: map[string]interface{}{
+				"id": "/DEFAULT_GROUP/key", "namespace": "", "group": "DEFAULT_GROUP", "key": "key",
+			},
+			expectId: "public/DEFAULT_GROUP/key",
+		},
+		{
+			name: "missing attributes",
+			rawState: map[string]interface{}{
+				"id": "sandbox/GROUP/key",
+			},
+			expectId: "sandbox/GROUP/key",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			state, err := resourceConfigurationStateUpgradeV0(context.Background(), tc.rawState, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectId, state["id"])
+		})
+	}
+}
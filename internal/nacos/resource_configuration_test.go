@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -91,6 +93,262 @@ func TestAccNacosConfiguration_basic(t *testing.T) {
 	})
 }
 
+func TestAccNacosConfiguration_sensitive(t *testing.T) {
+	var configuration nacos.Configuration
+	rKey := fmt.Sprintf("config-key-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosConfigurationValueConfig(rKey, "sensitive_value", _value1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosConfigurationExists("sample", &configuration),
+					testAccCheckNacosConfigurationAttributes(&configuration, &nacos.Configuration{Value: _value1}),
+					resource.TestCheckResourceAttr("nacos_configuration.sample", "sensitive_value", _value1),
+					resource.TestCheckNoResourceAttr("nacos_configuration.sample", "value"),
+				),
+			},
+			// only the hash is kept in the state
+			{
+				Config: testAccNacosConfigurationValueConfig(rKey, "write_only_value", _value2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosConfigurationExists("sample", &configuration),
+					testAccCheckNacosConfigurationAttributes(&configuration, &nacos.Configuration{Value: _value2}),
+					resource.TestCheckResourceAttr("nacos_configuration.sample", "write_only_value", hashValue(_value2)),
+					resource.TestCheckResourceAttr("nacos_configuration.sample", "md5", hashValue(_value2)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNacosConfiguration_contentObject(t *testing.T) {
+	var configuration nacos.Configuration
+	rKey := fmt.Sprintf("config-key-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosConfigurationContentObjectConfig(rKey, "yaml"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosConfigurationExists("sample", &configuration),
+					testAccCheckNacosConfigurationAttributes(&configuration, &nacos.Configuration{
+						Value: "server:\n  port: 8080",
+					}),
+				),
+			},
+			{
+				Config: testAccNacosConfigurationContentObjectConfig(rKey, "properties"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosConfigurationExists("sample", &configuration),
+					testAccCheckNacosConfigurationAttributes(&configuration, &nacos.Configuration{
+						Value: "server.port=8080",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccNacosConfigurationContentObjectConfig(rName, contentType string) string {
+	return fmt.Sprintf(`
+	resource "nacos_configuration" "sample" {
+		namespace = "%s"
+		group = "%s"
+		key = "%s"
+		type = "%s"
+		content_object = jsonencode({
+			server = { port = 8080 }
+		})
+	}
+	`, _namespace1, _group1, rName, contentType)
+}
+
+func TestAccNacosConfiguration_sourceFile(t *testing.T) {
+	var configuration nacos.Configuration
+	rKey := fmt.Sprintf("config-key-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	sourceFile := filepath.Join(t.TempDir(), "application.yaml")
+	writeSourceFile := func(content string) {
+		if err := os.WriteFile(sourceFile, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { writeSourceFile("port: ${port}") },
+				Config:    testAccNacosConfigurationSourceFileConfig(rKey, sourceFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosConfigurationExists("sample", &configuration),
+					testAccCheckNacosConfigurationAttributes(&configuration, &nacos.Configuration{Value: "port: 8080"}),
+					resource.TestCheckResourceAttr("nacos_configuration.sample", "type", "yaml"),
+					resource.TestCheckResourceAttr("nacos_configuration.sample", "source_hash", hashContent("port: 8080")),
+				),
+			},
+			// the file changed, re-publish
+			{
+				PreConfig: func() { writeSourceFile("port: ${port}\nhost: localhost") },
+				Config:    testAccNacosConfigurationSourceFileConfig(rKey, sourceFile),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosConfigurationExists("sample", &configuration),
+					testAccCheckNacosConfigurationAttributes(&configuration, &nacos.Configuration{Value: "port: 8080\nhost: localhost"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccNacosConfigurationSourceFileConfig(rName, sourceFile string) string {
+	return fmt.Sprintf(`
+	resource "nacos_configuration" "sample" {
+		namespace = "%s"
+		group = "%s"
+		key = "%s"
+		source_file = "%s"
+		template_vars = {
+			port = "8080"
+		}
+	}
+	`, _namespace1, _group1, rName, sourceFile)
+}
+
+func TestAccNacosConfiguration_publicNamespace(t *testing.T) {
+	var configuration nacos.Configuration
+	rKey := fmt.Sprintf("config-key-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "nacos_configuration" "sample" {
+					namespace = "public"
+					key = "%s"
+					value = "%s"
+				}
+				`, rKey, _value1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosConfigurationExists("sample", &configuration),
+					testAccCheckNacosConfigurationAttributes(&configuration, &nacos.Configuration{
+						Group: nacos.DefaultGroup,
+						Value: _value1,
+					}),
+					resource.TestCheckResourceAttr("nacos_configuration.sample", "id", "public/DEFAULT_GROUP/"+rKey),
+				),
+			},
+			// the other spelling of the public namespace plans nothing
+			{
+				Config: fmt.Sprintf(`
+				resource "nacos_configuration" "sample" {
+					group = "DEFAULT_GROUP"
+					key = "%s"
+					value = "%s"
+				}
+				`, rKey, _value1),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccNacosConfiguration_waitForListeners(t *testing.T) {
+	var configuration nacos.Configuration
+	rKey := fmt.Sprintf("config-key-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosConfigurationWaitForListenersConfig(rKey, _value1, 0, "error"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosConfigurationExists("sample", &configuration),
+					testAccCheckNacosConfigurationAttributes(&configuration, &nacos.Configuration{Value: _value1}),
+				),
+			},
+			// without any listener, the apply only warns
+			{
+				Config: testAccNacosConfigurationWaitForListenersConfig(rKey, _value2, 1, "warn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosConfigurationExists("sample", &configuration),
+					testAccCheckNacosConfigurationAttributes(&configuration, &nacos.Configuration{Value: _value2}),
+				),
+			},
+			{
+				Config:      testAccNacosConfigurationWaitForListenersConfig(rKey, _value1, 1, "error"),
+				ExpectError: regexp.MustCompile("did not converge"),
+			},
+		},
+	})
+}
+
+func TestAccNacosConfiguration_waitForListenersOnCreate(t *testing.T) {
+	var configuration nacos.Configuration
+	rKey := fmt.Sprintf("config-key-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosConfigurationDestroy,
+		Steps: []resource.TestStep{
+			// without any listener, the creation only warns even with on_timeout = "error"
+			{
+				Config: testAccNacosConfigurationWaitForListenersConfig(rKey, _value1, 1, "error"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosConfigurationExists("sample", &configuration),
+					testAccCheckNacosConfigurationAttributes(&configuration, &nacos.Configuration{Value: _value1}),
+				),
+			},
+			// not tainted, so not replaced
+			{
+				Config:   testAccNacosConfigurationWaitForListenersConfig(rKey, _value1, 1, "error"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccNacosConfigurationWaitForListenersConfig(rName, value string, minListeners int, onTimeout string) string {
+	return fmt.Sprintf(`
+	resource "nacos_configuration" "sample" {
+		namespace = "%s"
+		group = "%s"
+		key = "%s"
+		value = "%s"
+
+		wait_for_listeners {
+			min_listeners = %d
+			timeout = "5s"
+			on_timeout = "%s"
+		}
+	}
+	`, _namespace1, _group1, rName, value, minListeners, onTimeout)
+}
+
+func testAccNacosConfigurationValueConfig(rName, valueAttribute, value string) string {
+	return fmt.Sprintf(`
+	resource "nacos_configuration" "sample" {
+		namespace = "%s"
+		group = "%s"
+		key = "%s"
+		%s = "%s"
+	}
+	`, _namespace1, _group1, rName, valueAttribute, value)
+}
+
 // testAccNacosConfigurationConfig: generate a terraform config for nacos_configuration
 func testAccNacosConfigurationConfig(rName string, opts nacos.Configuration) string {
 	if opts.Namespace == "" {
@@ -0,0 +1,336 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func resourceConfigurations() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateNamespace,
+				DiffSuppressFunc: suppressEquivalentNamespace,
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nacos.DefaultGroup,
+				ValidateFunc: validateGroup,
+			},
+			"exclusive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// SDKv2 maps only hold primitive values, items are blocks identified by their key
+			"item": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateKey,
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateContent,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      nacos.ConfigurationTypeText,
+							ValidateFunc: validation.StringInSlice(nacos.ConfigurationTypes, false),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateDescription,
+						},
+						"tags": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: maxTagCount,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateTag,
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CreateContext: resourceConfigurationsCreate,
+		ReadContext:   resourceConfigurationsRead,
+		UpdateContext: resourceConfigurationsUpdate,
+		DeleteContext: resourceConfigurationsDelete,
+		CustomizeDiff: resourceConfigurationsCustomizeDiff,
+	}
+}
+
+func resourceConfigurationsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	seen := map[string]bool{}
+	for _, raw := range d.Get("item").(*schema.Set).List() {
+		key := raw.(map[string]interface{})["key"].(string)
+		if key == "" {
+			// unknown until apply
+			continue
+		}
+		if seen[key] {
+			return fmt.Errorf("duplicate item key: %s", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func resourceConfigurationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+	namespace, group := d.Get("namespace").(string), d.Get("group").(string)
+
+	items := expandConfigurationItems(namespace, group, d.Get("item").(*schema.Set))
+	for _, key := range sortedConfigurationKeys(items) {
+		configuration := items[key]
+		if err := client.PublishConfiguration(ctx, configuration); err != nil {
+			return diag.Errorf("failed to create configuration = %+v: %v", *configuration, err)
+		}
+	}
+	d.SetId(convToGroupResourceId(namespace, group))
+
+	if d.Get("exclusive").(bool) {
+		if diags := deleteUnmanagedConfigurations(ctx, client, namespace, group, items); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceConfigurationsRead(ctx, d, meta)
+}
+
+// resourceConfigurationsRead refreshes value and type of the items through the paginated search.
+// The search does not return description and tags, so they are kept as in the state.
+func resourceConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	namespace, group, err := convToGroupId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remoteItems, err := client.ListConfigurations(ctx, &nacos.SearchConfigurationsParams{
+		Namespace: namespace,
+		Group:     group,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	remote := make(map[string]nacos.ConfigurationInfo, len(remoteItems))
+	for _, item := range remoteItems {
+		remote[item.Key] = item
+	}
+
+	items := make([]interface{}, 0, len(remoteItems))
+	managed := map[string]bool{}
+	for _, raw := range d.Get("item").(*schema.Set).List() {
+		item := raw.(map[string]interface{})
+		key := item["key"].(string)
+		managed[key] = true
+
+		remoteItem, ok := remote[key]
+		if !ok {
+			continue
+		}
+		item["value"] = remoteItem.Value
+		if remoteItem.Type != "" {
+			item["type"] = remoteItem.Type
+		}
+		items = append(items, item)
+	}
+
+	// surface unmanaged configurations, so that they are planned for deletion
+	if d.Get("exclusive").(bool) {
+		for _, remoteItem := range remoteItems {
+			if managed[remoteItem.Key] {
+				continue
+			}
+			items = append(items, map[string]interface{}{
+				"key":   remoteItem.Key,
+				"value": remoteItem.Value,
+				"type":  remoteItem.Type,
+			})
+		}
+	}
+
+	for k, v := range map[string]interface{}{
+		"namespace": namespace,
+		"group":     group,
+		"item":      items,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceConfigurationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+	namespace, group := d.Get("namespace").(string), d.Get("group").(string)
+
+	oldRaw, newRaw := d.GetChange("item")
+	oldItems := expandConfigurationItems(namespace, group, oldRaw.(*schema.Set))
+	newItems := expandConfigurationItems(namespace, group, newRaw.(*schema.Set))
+
+	for _, key := range sortedConfigurationKeys(newItems) {
+		configuration := newItems[key]
+		if old, ok := oldItems[key]; ok && *old == *configuration {
+			continue
+		}
+		if err := client.PublishConfiguration(ctx, configuration); err != nil {
+			return diag.Errorf("failed to update configuration = %+v: %v", *configuration, err)
+		}
+	}
+
+	removed := map[string]bool{}
+	for key := range oldItems {
+		if _, ok := newItems[key]; !ok {
+			removed[key] = true
+		}
+	}
+	if len(removed) > 0 {
+		if diags := deleteConfigurationsByKey(ctx, client, namespace, group, func(key string) bool {
+			return removed[key]
+		}); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.Get("exclusive").(bool) {
+		if diags := deleteUnmanagedConfigurations(ctx, client, namespace, group, newItems); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceConfigurationsRead(ctx, d, meta)
+}
+
+func resourceConfigurationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+	namespace, group, err := convToGroupId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := expandConfigurationItems(namespace, group, d.Get("item").(*schema.Set))
+	return deleteConfigurationsByKey(ctx, client, namespace, group, func(key string) bool {
+		_, ok := items[key]
+		return ok
+	})
+}
+
+// deleteUnmanagedConfigurations deletes the configurations of the group which are not in items
+func deleteUnmanagedConfigurations(ctx context.Context, client *nacos.Client, namespace, group string, items map[string]*nacos.Configuration) diag.Diagnostics {
+	return deleteConfigurationsByKey(ctx, client, namespace, group, func(key string) bool {
+		_, ok := items[key]
+		return !ok
+	})
+}
+
+// deleteConfigurationsByKey deletes the configurations of the group selected by match with batch requests,
+// failures are reported per configuration
+func deleteConfigurationsByKey(ctx context.Context, client *nacos.Client, namespace, group string, match func(key string) bool) diag.Diagnostics {
+	remoteItems, err := client.ListConfigurations(ctx, &nacos.SearchConfigurationsParams{
+		Namespace: namespace,
+		Group:     group,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ids []string
+	keys := map[string]string{}
+	for _, remoteItem := range remoteItems {
+		if !match(remoteItem.Key) {
+			continue
+		}
+		id := remoteItem.Id.String()
+		ids = append(ids, id)
+		keys[id] = remoteItem.Key
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	err = client.DeleteConfigurations(ctx, ids)
+	if err == nil {
+		return nil
+	}
+	deleteErr, ok := err.(*nacos.DeleteConfigurationsError)
+	if !ok {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	for _, id := range ids {
+		if itemErr, failed := deleteErr.Failed[id]; failed {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("failed to delete configuration = %s/%s/%s", namespace, group, keys[id]),
+				Detail:   itemErr.Error(),
+			})
+		}
+	}
+	return diags
+}
+
+func expandConfigurationItems(namespace, group string, set *schema.Set) map[string]*nacos.Configuration {
+	items := make(map[string]*nacos.Configuration, set.Len())
+	for _, raw := range set.List() {
+		item := raw.(map[string]interface{})
+
+		var tags []string
+		if rawTags, ok := item["tags"].(*schema.Set); ok {
+			for _, tag := range rawTags.List() {
+				tags = append(tags, tag.(string))
+			}
+		}
+		sort.Strings(tags)
+
+		key := item["key"].(string)
+		items[key] = &nacos.Configuration{
+			Namespace:   namespace,
+			Group:       group,
+			Key:         key,
+			Value:       item["value"].(string),
+			Description: item["description"].(string),
+			Type:        item["type"].(string),
+			Tags:        strings.Join(tags, ","),
+		}
+	}
+	return items
+}
+
+func sortedConfigurationKeys(items map[string]*nacos.Configuration) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,148 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func TestAccNacosConfigurations_basic(t *testing.T) {
+	rGroup := fmt.Sprintf("BULK_%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosConfigurationsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosConfigurationsConfig(rGroup, false, map[string]string{
+					"key-1": _value1,
+					"key-2": _value1,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nacos_configurations.sample", "item.#", "2"),
+					testAccCheckNacosConfigurationsValues(rGroup, map[string]string{
+						"key-1": _value1,
+						"key-2": _value1,
+					}),
+				),
+			},
+			// update one item, remove another one
+			{
+				Config: testAccNacosConfigurationsConfig(rGroup, false, map[string]string{
+					"key-1": _value2,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nacos_configurations.sample", "item.#", "1"),
+					testAccCheckNacosConfigurationsValues(rGroup, map[string]string{
+						"key-1": _value2,
+						"key-2": "",
+					}),
+				),
+			},
+			// exclusive, remove unmanaged items
+			{
+				PreConfig: func() {
+					err := testNacosClient.PublishConfiguration(context.Background(), &nacos.Configuration{
+						Namespace: _namespace1,
+						Group:     rGroup,
+						Key:       "unmanaged",
+						Value:     _value1,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccNacosConfigurationsConfig(rGroup, true, map[string]string{
+					"key-1": _value2,
+				}),
+				Check: testAccCheckNacosConfigurationsValues(rGroup, map[string]string{
+					"key-1":     _value2,
+					"unmanaged": "",
+				}),
+			},
+		},
+	})
+}
+
+func testAccNacosConfigurationsConfig(group string, exclusive bool, items map[string]string) string {
+	return fmt.Sprintf(`
+	variable "items" {
+		type = map(string)
+		default = %s
+	}
+
+	resource "nacos_configurations" "sample" {
+		namespace = "%s"
+		group = "%s"
+		exclusive = %t
+
+		dynamic "item" {
+			for_each = var.items
+			content {
+				key = item.key
+				value = item.value
+			}
+		}
+	}
+	`, testAccHCLMap(items), _namespace1, group, exclusive)
+}
+
+func testAccHCLMap(m map[string]string) string {
+	s := "{\n"
+	for k, v := range m {
+		s += fmt.Sprintf("\t\t\t%q = %q\n", k, v)
+	}
+	return s + "\t\t}"
+}
+
+// testAccCheckNacosConfigurationsValues checks the values on the server, an empty value means the item must not exist
+func testAccCheckNacosConfigurationsValues(group string, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for key, value := range want {
+			c, err := testNacosClient.GetConfiguration(
+				context.Background(),
+				&nacos.ConfigurationId{Namespace: _namespace1, Group: group, Key: key})
+			if value == "" {
+				if err == nil {
+					return fmt.Errorf("configuration (%s, %s) still exists", group, key)
+				}
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if c.Value != value {
+				return fmt.Errorf("got value %s, want %s", c.Value, value)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckNacosConfigurationsDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nacos_configurations" {
+			continue
+		}
+
+		items, err := testNacosClient.ListConfigurations(context.Background(), &nacos.SearchConfigurationsParams{
+			Namespace: rs.Primary.Attributes["namespace"],
+			Group:     rs.Primary.Attributes["group"],
+		})
+		if err != nil {
+			return err
+		}
+		if len(items) > 0 {
+			return fmt.Errorf("%d configurations still exist in group %s", len(items), rs.Primary.Attributes["group"])
+		}
+	}
+
+	return nil
+}
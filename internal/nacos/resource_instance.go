@@ -0,0 +1,203 @@
+package nacos
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+// resourceInstance registers persistent instances, for services which cannot register themselves
+func resourceInstance() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateNamespace,
+				DiffSuppressFunc: suppressEquivalentNamespace,
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nacos.DefaultGroup,
+				ValidateFunc: validateGroup,
+			},
+			"service": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateServiceName,
+			},
+			"cluster": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nacos.DefaultCluster,
+				ValidateFunc: validateClusterName,
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"weight": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.FloatBetween(0, 10000),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			// reported by the health checks of the cluster, the instance API cannot override it,
+			// see nacos_instance_maintenance
+			"healthy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CreateContext: resourceInstanceCreate,
+		ReadContext:   resourceInstanceRead,
+		UpdateContext: resourceInstanceUpdate,
+		DeleteContext: resourceInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	instance := expandInstance(d)
+	if err := client.RegisterInstance(ctx, instance); err != nil {
+		return diag.Errorf("failed to register instance = %+v: %v", *instance, err)
+	}
+
+	d.SetId(convToInstanceResourceId(&nacos.InstanceId{
+		Namespace: instance.Namespace,
+		Group:     instance.Group,
+		Service:   instance.Service,
+		Cluster:   instance.Cluster,
+		Ip:        instance.Ip,
+		Port:      instance.Port,
+	}))
+
+	return resourceInstanceRead(ctx, d, meta)
+}
+
+// resourceInstanceRead reads the instance through the catalog of its cluster, which lists disabled instances too.
+// An instance which is not registered anymore is removed from the state
+func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	instanceId, err := convToInstanceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instance, err := client.GetInstance(ctx, instanceId)
+	if err != nil {
+		if errors.Is(err, nacos.ErrNotFound) && !d.IsNewResource() {
+			log.Printf("[WARN] instance %s is not registered anymore, removing it from the state\n", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	for k, v := range map[string]interface{}{
+		"namespace":   instance.Namespace,
+		"group":       instance.Group,
+		"service":     instance.Service,
+		"cluster":     instance.Cluster,
+		"ip":          instance.Ip,
+		"port":        instance.Port,
+		"weight":      instance.Weight,
+		"enabled":     instance.Enabled,
+		"healthy":     instance.Healthy,
+		"metadata":    instance.Metadata,
+		"instance_id": instance.InstanceId,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+	if d.HasChanges("weight", "enabled", "metadata") {
+		instance := expandInstance(d)
+		if err := client.UpdateInstance(ctx, instance); err != nil {
+			return diag.Errorf("failed to update instance = %+v: %v", *instance, err)
+		}
+	}
+
+	return resourceInstanceRead(ctx, d, meta)
+}
+
+func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+	instanceId, err := convToInstanceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.DeregisterInstance(ctx, instanceId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandInstance(d *schema.ResourceData) *nacos.Instance {
+	// new instances are registered healthy, then the health reported by the cluster is kept
+	healthy := d.Get("healthy").(bool)
+	if d.Id() == "" {
+		healthy = true
+	}
+
+	return &nacos.Instance{
+		Namespace: d.Get("namespace").(string),
+		Group:     d.Get("group").(string),
+		Service:   d.Get("service").(string),
+		Cluster:   d.Get("cluster").(string),
+		Ip:        d.Get("ip").(string),
+		Port:      d.Get("port").(int),
+		Weight:    d.Get("weight").(float64),
+		Enabled:   d.Get("enabled").(bool),
+		Healthy:   healthy,
+		Ephemeral: false,
+		Metadata:  expandStringMap(d.Get("metadata").(map[string]interface{})),
+	}
+}
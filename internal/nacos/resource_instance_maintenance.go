@@ -0,0 +1,239 @@
+package nacos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+// resourceInstanceMaintenance takes an instance out of rotation for the lifetime of the resource,
+// the original state of the instance is restored on destroy
+func resourceInstanceMaintenance() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateNamespace,
+				DiffSuppressFunc: suppressEquivalentNamespace,
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nacos.DefaultGroup,
+				ValidateFunc: validateGroup,
+			},
+			"service": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateServiceName,
+			},
+			"cluster": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nacos.DefaultCluster,
+				ValidateFunc: validateClusterName,
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// only overridden when it is set
+			"healthy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"original_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"original_healthy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"healthy_overridden": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+
+		CreateContext: resourceInstanceMaintenanceCreate,
+		ReadContext:   resourceInstanceMaintenanceRead,
+		UpdateContext: resourceInstanceMaintenanceUpdate,
+		DeleteContext: resourceInstanceMaintenanceDelete,
+	}
+}
+
+func resourceInstanceMaintenanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	instanceId := &nacos.InstanceId{
+		Namespace: d.Get("namespace").(string),
+		Group:     d.Get("group").(string),
+		Service:   d.Get("service").(string),
+		Cluster:   d.Get("cluster").(string),
+		Ip:        d.Get("ip").(string),
+		Port:      d.Get("port").(int),
+	}
+	instance, err := client.GetInstance(ctx, instanceId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for k, v := range map[string]interface{}{
+		"original_enabled":   instance.Enabled,
+		"original_healthy":   instance.Healthy,
+		"healthy_overridden": false,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(convToInstanceResourceId(instanceId))
+
+	if diags := applyInstanceMaintenance(ctx, client, d, instance, !d.GetRawConfig().GetAttr("healthy").IsNull()); diags.HasError() {
+		return diags
+	}
+
+	return resourceInstanceMaintenanceRead(ctx, d, meta)
+}
+
+func resourceInstanceMaintenanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	instanceId, err := convToInstanceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instance, err := client.GetInstance(ctx, instanceId)
+	if err != nil {
+		// the state is kept, so that the original state is still restored when the instance registers again
+		if errors.Is(err, nacos.ErrNotFound) && !d.IsNewResource() {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("instance %s is not registered anymore", d.Id()),
+				Detail:   "the maintenance is kept in the state, destroying it fails until the instance registers again",
+			}}
+		}
+		return diag.FromErr(err)
+	}
+
+	for k, v := range map[string]interface{}{
+		"namespace": instance.Namespace,
+		"group":     instance.Group,
+		"service":   instance.Service,
+		"cluster":   instance.Cluster,
+		"ip":        instance.Ip,
+		"port":      instance.Port,
+		"enabled":   instance.Enabled,
+		"healthy":   instance.Healthy,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceInstanceMaintenanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	instanceId, err := convToInstanceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instance, err := client.GetInstance(ctx, instanceId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	overrideHealthy := d.HasChange("healthy") && !d.GetRawConfig().GetAttr("healthy").IsNull()
+	if diags := applyInstanceMaintenance(ctx, client, d, instance, overrideHealthy); diags.HasError() {
+		return diags
+	}
+
+	return resourceInstanceMaintenanceRead(ctx, d, meta)
+}
+
+// resourceInstanceMaintenanceDelete restores the enabled flag and the health the instance had before the maintenance
+func resourceInstanceMaintenanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	instanceId, err := convToInstanceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// an instance which cannot be found cannot be restored, the error is reported rather than forgetting the original state
+	instance, err := client.GetInstance(ctx, instanceId)
+	if err != nil {
+		return diag.Errorf("failed to restore instance = %s: %v", d.Id(), err)
+	}
+
+	if originalEnabled := d.Get("original_enabled").(bool); instance.Enabled != originalEnabled {
+		instance.Enabled = originalEnabled
+		if err := client.UpdateInstance(ctx, instance); err != nil {
+			return diag.Errorf("failed to restore instance = %s: %v", d.Id(), err)
+		}
+	}
+	if d.Get("healthy_overridden").(bool) {
+		if err := client.UpdateInstanceHealth(ctx, instanceId, d.Get("original_healthy").(bool)); err != nil {
+			return diag.Errorf("failed to restore health of instance = %s: %v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// applyInstanceMaintenance updates the instance with its current weight and metadata, only the enabled flag changes
+func applyInstanceMaintenance(ctx context.Context, client *nacos.Client, d *schema.ResourceData, instance *nacos.Instance, overrideHealthy bool) diag.Diagnostics {
+	if enabled := d.Get("enabled").(bool); instance.Enabled != enabled {
+		instance.Enabled = enabled
+		if err := client.UpdateInstance(ctx, instance); err != nil {
+			return diag.Errorf("failed to update instance = %s: %v", d.Id(), err)
+		}
+	}
+
+	if overrideHealthy {
+		instanceId := &nacos.InstanceId{
+			Namespace: instance.Namespace,
+			Group:     instance.Group,
+			Service:   instance.Service,
+			Cluster:   instance.Cluster,
+			Ip:        instance.Ip,
+			Port:      instance.Port,
+		}
+		if err := client.UpdateInstanceHealth(ctx, instanceId, d.Get("healthy").(bool)); err != nil {
+			return diag.Errorf("failed to update health of instance = %s: %v", d.Id(), err)
+		}
+		if err := d.Set("healthy_overridden", true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
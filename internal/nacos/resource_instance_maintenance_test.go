@@ -0,0 +1,143 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func TestAccNacosInstanceMaintenance_basic(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosInstanceMaintenanceConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosInstanceEnabled("sample", false),
+					resource.TestCheckResourceAttr("nacos_instance_maintenance.sample", "enabled", "false"),
+					resource.TestCheckResourceAttr("nacos_instance_maintenance.sample", "original_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccNacosInstanceMaintenanceConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosInstanceEnabled("sample", true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNacosInstanceMaintenance_instanceDisappears(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosInstanceMaintenanceConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosInstanceEnabled("sample", false),
+				),
+			},
+			// the maintenance and its original state are kept while the instance is not registered
+			{
+				PreConfig: func() {
+					err := testNacosClient.DeregisterInstance(context.Background(), &nacos.InstanceId{
+						Namespace: _namespace1,
+						Group:     _group1,
+						Service:   rName,
+						Ip:        "10.0.0.1",
+						Port:      3306,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccNacosInstanceMaintenanceConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nacos_instance_maintenance.sample", "original_enabled", "true"),
+				),
+				// the instance is registered again enabled, the maintenance disables it on the next apply
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccNacosInstanceMaintenanceConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosInstanceEnabled("sample", false),
+					resource.TestCheckResourceAttr("nacos_instance_maintenance.sample", "original_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNacosInstanceMaintenanceConfig(rName string, maintenance bool) string {
+	config := fmt.Sprintf(`
+	resource "nacos_service" "sample" {
+		namespace = "%s"
+		group = "%s"
+		name = "%s"
+	}
+
+	resource "nacos_instance" "sample" {
+		namespace = nacos_service.sample.namespace
+		group = nacos_service.sample.group
+		service = nacos_service.sample.name
+		ip = "10.0.0.1"
+		port = 3306
+
+		lifecycle {
+			ignore_changes = [enabled]
+		}
+	}
+	`, _namespace1, _group1, rName)
+	if !maintenance {
+		return config
+	}
+
+	return config + `
+	resource "nacos_instance_maintenance" "sample" {
+		namespace = nacos_instance.sample.namespace
+		group = nacos_instance.sample.group
+		service = nacos_instance.sample.service
+		ip = nacos_instance.sample.ip
+		port = nacos_instance.sample.port
+	}
+	`
+}
+
+func testAccCheckNacosInstanceEnabled(resourceName string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[fmt.Sprintf("nacos_instance.%s", resourceName)]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		instanceId, err := convToInstanceId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error in splitting instance ID: %v", err)
+		}
+
+		instance, err := testNacosClient.GetInstance(context.Background(), instanceId)
+		if err != nil {
+			return err
+		}
+		if instance.Enabled != enabled {
+			return fmt.Errorf("expected instance %+v to be enabled=%v", *instanceId, enabled)
+		}
+		return nil
+	}
+}
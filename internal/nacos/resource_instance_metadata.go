@@ -0,0 +1,270 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+// resourceInstanceMetadata owns metadata entries of instances which register themselves,
+// only the keys of `metadata` are written, read and deleted
+func resourceInstanceMetadata() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateNamespace,
+				DiffSuppressFunc: suppressEquivalentNamespace,
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nacos.DefaultGroup,
+				ValidateFunc: validateGroup,
+			},
+			"service": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateServiceName,
+			},
+			"consistency_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nacos.ConsistencyTypeEphemeral,
+				ValidateFunc: validation.StringInSlice([]string{nacos.ConsistencyTypeEphemeral, nacos.ConsistencyTypePersist}, false),
+			},
+			// all the instances of consistency_type when empty
+			"instance": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"cluster": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      nacos.DefaultCluster,
+							ValidateFunc: validateClusterName,
+						},
+					},
+				},
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"updated_instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CreateContext: resourceInstanceMetadataCreate,
+		ReadContext:   resourceInstanceMetadataRead,
+		UpdateContext: resourceInstanceMetadataUpdate,
+		DeleteContext: resourceInstanceMetadataDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceInstanceMetadataImport,
+		},
+	}
+}
+
+func resourceInstanceMetadataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	params := expandInstancesMetadataParams(d, expandStringMap(d.Get("metadata").(map[string]interface{})))
+	result, err := client.UpdateInstancesMetadata(ctx, params)
+	if err != nil {
+		return diag.Errorf("failed to update metadata of service = %s: %v", params.Service, err)
+	}
+	if err := d.Set("updated_instances", result.Updated); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(joinResourceId(namespaceIdPart(params.Namespace), params.Group, params.Service, params.ConsistencyType))
+
+	return resourceInstanceMetadataRead(ctx, d, meta)
+}
+
+// resourceInstanceMetadataRead reports the owned keys which differ on any of the selected instances
+func resourceInstanceMetadataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	parts, err := splitResourceId(d.Id(), 4)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	namespace, group, service, consistencyType := nacos.NormalizeNamespace(parts[0]), parts[1], parts[2], parts[3]
+
+	// disabled instances are updated by the batch metadata API too
+	instances, err := client.ListServiceInstances(ctx, &nacos.ServiceId{
+		Namespace: namespace,
+		Group:     group,
+		Name:      service,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	targets := map[string]bool{}
+	for _, raw := range d.Get("instance").(*schema.Set).List() {
+		target := raw.(map[string]interface{})
+		targets[instanceAddress(target["cluster"].(string), target["ip"].(string), target["port"].(int))] = true
+	}
+
+	owned := d.Get("metadata").(map[string]interface{})
+	metadata := make(map[string]interface{}, len(owned))
+	for k, v := range owned {
+		metadata[k] = v
+	}
+	for _, instance := range instances {
+		if instance.Ephemeral != (consistencyType == nacos.ConsistencyTypeEphemeral) {
+			continue
+		}
+		if len(targets) > 0 && !targets[instanceAddress(instance.Cluster, instance.Ip, instance.Port)] {
+			continue
+		}
+		for k, v := range owned {
+			actual, ok := instance.Metadata[k]
+			if !ok {
+				delete(metadata, k)
+			} else if actual != v.(string) {
+				metadata[k] = actual
+			}
+		}
+	}
+
+	for k, v := range map[string]interface{}{
+		"namespace":        namespace,
+		"group":            group,
+		"service":          service,
+		"consistency_type": consistencyType,
+		"metadata":         metadata,
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceInstanceMetadataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+	if !d.HasChange("metadata") {
+		return resourceInstanceMetadataRead(ctx, d, meta)
+	}
+
+	oldRaw, newRaw := d.GetChange("metadata")
+	oldMetadata, newMetadata := expandStringMap(oldRaw.(map[string]interface{})), expandStringMap(newRaw.(map[string]interface{}))
+	removed := map[string]string{}
+	for k, v := range oldMetadata {
+		if _, ok := newMetadata[k]; !ok {
+			removed[k] = v
+		}
+	}
+	if len(removed) > 0 {
+		params := expandInstancesMetadataParams(d, removed)
+		if _, err := client.DeleteInstancesMetadata(ctx, params); err != nil {
+			return diag.Errorf("failed to delete metadata of service = %s: %v", params.Service, err)
+		}
+	}
+
+	params := expandInstancesMetadataParams(d, newMetadata)
+	result, err := client.UpdateInstancesMetadata(ctx, params)
+	if err != nil {
+		return diag.Errorf("failed to update metadata of service = %s: %v", params.Service, err)
+	}
+	if err := d.Set("updated_instances", result.Updated); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceInstanceMetadataRead(ctx, d, meta)
+}
+
+// resourceInstanceMetadataDelete only deletes the owned keys, the instances stay registered
+func resourceInstanceMetadataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	params := expandInstancesMetadataParams(d, expandStringMap(d.Get("metadata").(map[string]interface{})))
+	if _, err := client.DeleteInstancesMetadata(ctx, params); err != nil {
+		return diag.Errorf("failed to delete metadata of service = %s: %v", params.Service, err)
+	}
+
+	return nil
+}
+
+// resourceInstanceMetadataImport takes the owned keys after the ID: `<namespace>/<group>/<service>/<consistency_type>/<key>,<key>`,
+// the metadata of all the instances of consistency_type is imported
+func resourceInstanceMetadataImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts, err := splitResourceId(d.Id(), 5)
+	if err != nil {
+		return nil, fmt.Errorf("expected ID <namespace>/<group>/<service>/<consistency_type>/<keys>: %v", err)
+	}
+
+	metadata := map[string]interface{}{}
+	for _, key := range strings.Split(parts[4], ",") {
+		if key != "" {
+			metadata[key] = ""
+		}
+	}
+	if len(metadata) == 0 {
+		return nil, fmt.Errorf("no metadata key to import in ID: %s", d.Id())
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return nil, err
+	}
+
+	d.SetId(joinResourceId(parts[:4]...))
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandInstancesMetadataParams(d *schema.ResourceData, metadata map[string]string) *nacos.InstancesMetadataParams {
+	params := &nacos.InstancesMetadataParams{
+		Namespace:       d.Get("namespace").(string),
+		Group:           d.Get("group").(string),
+		Service:         d.Get("service").(string),
+		ConsistencyType: d.Get("consistency_type").(string),
+		Metadata:        metadata,
+	}
+	for _, raw := range d.Get("instance").(*schema.Set).List() {
+		target := raw.(map[string]interface{})
+		params.Instances = append(params.Instances, nacos.InstancesMetadataTarget{
+			Ip:      target["ip"].(string),
+			Port:    target["port"].(int),
+			Cluster: target["cluster"].(string),
+		})
+	}
+	sort.Slice(params.Instances, func(i, j int) bool {
+		a, b := params.Instances[i], params.Instances[j]
+		return instanceAddress(a.Cluster, a.Ip, a.Port) < instanceAddress(b.Cluster, b.Ip, b.Port)
+	})
+	return params
+}
+
+func instanceAddress(cluster, ip string, port int) string {
+	return fmt.Sprintf("%s/%s:%d", cluster, ip, port)
+}
@@ -0,0 +1,115 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func TestAccNacosInstanceMetadata_basic(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosInstanceMetadataConfig(rName, `canary = "true"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosInstanceMetadata(map[string]string{"zone": "a", "canary": "true"}),
+					resource.TestCheckResourceAttr("nacos_instance_metadata.sample", "updated_instances.#", "1"),
+				),
+			},
+			{
+				Config: testAccNacosInstanceMetadataConfig(rName, `owner = "ops"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosInstanceMetadata(map[string]string{"zone": "a", "owner": "ops"}),
+				),
+			},
+			{
+				ResourceName:            "nacos_instance_metadata.sample",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s/%s/persist/owner", _namespace1, _group1, rName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"updated_instances", "instance"},
+			},
+		},
+	})
+}
+
+// the metadata of the instance itself is kept, only the owned keys change
+func testAccNacosInstanceMetadataConfig(rName, metadata string) string {
+	return fmt.Sprintf(`
+	resource "nacos_service" "sample" {
+		namespace = "%s"
+		group = "%s"
+		name = "%s"
+	}
+
+	resource "nacos_instance" "sample" {
+		namespace = nacos_service.sample.namespace
+		group = nacos_service.sample.group
+		service = nacos_service.sample.name
+		ip = "10.0.0.1"
+		port = 3306
+
+		metadata = {
+			zone = "a"
+		}
+
+		lifecycle {
+			ignore_changes = [metadata]
+		}
+	}
+
+	resource "nacos_instance_metadata" "sample" {
+		namespace = nacos_instance.sample.namespace
+		group = nacos_instance.sample.group
+		service = nacos_instance.sample.service
+		consistency_type = "persist"
+
+		instance {
+			ip = nacos_instance.sample.ip
+			port = nacos_instance.sample.port
+		}
+
+		metadata = {
+			%s
+		}
+	}
+	`, _namespace1, _group1, rName, metadata)
+}
+
+func testAccCheckNacosInstanceMetadata(want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["nacos_instance.sample"]
+		if !ok {
+			return fmt.Errorf("not found: nacos_instance.sample")
+		}
+		instanceId, err := convToInstanceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var instance *nacos.Instance
+		if instance, err = testNacosClient.GetInstance(context.Background(), instanceId); err != nil {
+			return err
+		}
+		if len(instance.Metadata) != len(want) {
+			return fmt.Errorf("expected metadata %v, got %v", want, instance.Metadata)
+		}
+		for k, v := range want {
+			if instance.Metadata[k] != v {
+				return fmt.Errorf("expected metadata %v, got %v", want, instance.Metadata)
+			}
+		}
+		return nil
+	}
+}
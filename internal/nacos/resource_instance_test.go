@@ -0,0 +1,157 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNacosInstance_basic(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosInstanceConfig(rName, 1, "a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosInstanceExists("sample"),
+					resource.TestCheckResourceAttr("nacos_instance.sample", "weight", "1"),
+					resource.TestCheckResourceAttr("nacos_instance.sample", "metadata.zone", "a"),
+				),
+			},
+			{
+				Config: testAccNacosInstanceConfig(rName, 2.5, "b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosInstanceExists("sample"),
+					resource.TestCheckResourceAttr("nacos_instance.sample", "weight", "2.5"),
+					resource.TestCheckResourceAttr("nacos_instance.sample", "metadata.zone", "b"),
+				),
+			},
+			{
+				ResourceName:      "nacos_instance.sample",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNacosInstance_disabled(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosInstanceDisabledConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosInstanceExists("sample"),
+					resource.TestCheckResourceAttr("nacos_instance.sample", "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccNacosInstanceDisabledConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosInstanceExists("sample"),
+					resource.TestCheckResourceAttr("nacos_instance.sample", "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "nacos_instance.sample",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNacosInstanceDisabledConfig(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "nacos_service" "sample" {
+		namespace = "%s"
+		group = "%s"
+		name = "%s"
+	}
+
+	resource "nacos_instance" "sample" {
+		namespace = nacos_service.sample.namespace
+		group = nacos_service.sample.group
+		service = nacos_service.sample.name
+		ip = "10.0.0.1"
+		port = 3306
+		enabled = %v
+	}
+	`, _namespace1, _group1, rName, enabled)
+}
+
+func testAccNacosInstanceConfig(rName string, weight float64, zone string) string {
+	return fmt.Sprintf(`
+	resource "nacos_service" "sample" {
+		namespace = "%s"
+		group = "%s"
+		name = "%s"
+	}
+
+	resource "nacos_instance" "sample" {
+		namespace = nacos_service.sample.namespace
+		group = nacos_service.sample.group
+		service = nacos_service.sample.name
+		ip = "10.0.0.1"
+		port = 3306
+		weight = %v
+
+		metadata = {
+			zone = "%s"
+		}
+	}
+	`, _namespace1, _group1, rName, weight, zone)
+}
+
+func testAccCheckNacosInstanceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nacos_instance" {
+			continue
+		}
+
+		instanceId, err := convToInstanceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, err = testNacosClient.GetInstance(context.Background(), instanceId)
+		if err == nil {
+			return fmt.Errorf("instance %+v still exists", *instanceId)
+		}
+		if !strings.Contains(err.Error(), "not found") {
+			return err
+		}
+	}
+
+	return testAccCheckNacosServiceDestroy(s)
+}
+
+func testAccCheckNacosInstanceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[fmt.Sprintf("nacos_instance.%s", resourceName)]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		instanceId, err := convToInstanceId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error in splitting instance ID: %v", err)
+		}
+
+		_, err = testNacosClient.GetInstance(context.Background(), instanceId)
+		return err
+	}
+}
@@ -0,0 +1,327 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+// namingSwitchesId is the ID of the singleton, the switches are shared by the whole Nacos cluster
+const namingSwitchesId = "naming_switches"
+
+// namingSwitch maps an attribute to its entry of the naming switches,
+// the push versions share the pushVersion entry and are told apart by their client
+type namingSwitch struct {
+	attribute string
+	entry     string
+	client    string
+	valueType schema.ValueType
+	validate  schema.SchemaValidateFunc
+	value     func(*nacos.Switches) interface{}
+}
+
+var namingSwitches = []namingSwitch{
+	{
+		attribute: "distro_threshold",
+		entry:     nacos.SwitchEntryDistroThreshold,
+		valueType: schema.TypeFloat,
+		validate:  validation.FloatBetween(0, 1),
+		value:     func(s *nacos.Switches) interface{} { return s.DistroThreshold },
+	},
+	{
+		attribute: "distro_enabled",
+		entry:     nacos.SwitchEntryDistroEnabled,
+		valueType: schema.TypeBool,
+		value:     func(s *nacos.Switches) interface{} { return s.DistroEnabled },
+	},
+	{
+		attribute: "distro_server_expired_millis",
+		entry:     nacos.SwitchEntryDistroServerExpiredMillis,
+		valueType: schema.TypeInt,
+		validate:  validation.IntAtLeast(1),
+		value:     func(s *nacos.Switches) interface{} { return int(s.DistroServerExpiredMillis) },
+	},
+	{
+		attribute: "client_beat_interval",
+		entry:     nacos.SwitchEntryClientBeatInterval,
+		valueType: schema.TypeInt,
+		validate:  validation.IntAtLeast(1),
+		value:     func(s *nacos.Switches) interface{} { return int(s.ClientBeatInterval) },
+	},
+	{
+		attribute: "health_check_enabled",
+		entry:     nacos.SwitchEntryHealthCheckEnabled,
+		valueType: schema.TypeBool,
+		value:     func(s *nacos.Switches) interface{} { return s.HealthCheckEnabled },
+	},
+	{
+		attribute: "auto_change_health_check_enabled",
+		entry:     nacos.SwitchEntryAutoChangeHealthCheckEnabled,
+		valueType: schema.TypeBool,
+		value:     func(s *nacos.Switches) interface{} { return s.AutoChangeHealthCheckEnabled },
+	},
+	{
+		attribute: "check_times",
+		entry:     nacos.SwitchEntryHealthCheckTimes,
+		valueType: schema.TypeInt,
+		validate:  validation.IntAtLeast(1),
+		value:     func(s *nacos.Switches) interface{} { return s.CheckTimes },
+	},
+	{
+		attribute: "light_beat_enabled",
+		entry:     nacos.SwitchEntryLightBeatEnabled,
+		valueType: schema.TypeBool,
+		value:     func(s *nacos.Switches) interface{} { return s.LightBeatEnabled },
+	},
+	{
+		attribute: "send_beat_only",
+		entry:     nacos.SwitchEntrySendBeatOnly,
+		valueType: schema.TypeBool,
+		value:     func(s *nacos.Switches) interface{} { return s.SendBeatOnly },
+	},
+	{
+		attribute: "disable_add_ip",
+		entry:     nacos.SwitchEntryDisableAddIP,
+		valueType: schema.TypeBool,
+		value:     func(s *nacos.Switches) interface{} { return s.DisableAddIP },
+	},
+	{
+		attribute: "enable_standalone",
+		entry:     nacos.SwitchEntryEnableStandalone,
+		valueType: schema.TypeBool,
+		value:     func(s *nacos.Switches) interface{} { return s.EnableStandalone },
+	},
+	{
+		attribute: "default_instance_ephemeral",
+		entry:     nacos.SwitchEntryDefaultInstanceEphemeral,
+		valueType: schema.TypeBool,
+		value:     func(s *nacos.Switches) interface{} { return s.DefaultInstanceEphemeral },
+	},
+	{
+		attribute: "push_enabled",
+		entry:     nacos.SwitchEntryPushEnabled,
+		valueType: schema.TypeBool,
+		value:     func(s *nacos.Switches) interface{} { return s.PushEnabled },
+	},
+	// the minimums below are enforced by SwitchManager
+	{
+		attribute: "default_push_cache_millis",
+		entry:     nacos.SwitchEntryPushCacheMillis,
+		valueType: schema.TypeInt,
+		validate:  validation.IntAtLeast(10000),
+		value:     func(s *nacos.Switches) interface{} { return int(s.DefaultPushCacheMillis) },
+	},
+	{
+		attribute: "default_cache_millis",
+		entry:     nacos.SwitchEntryDefaultCacheMillis,
+		valueType: schema.TypeInt,
+		validate:  validation.IntAtLeast(1000),
+		value:     func(s *nacos.Switches) interface{} { return int(s.DefaultCacheMillis) },
+	},
+	{
+		attribute: "service_status_synchronization_period_millis",
+		entry:     nacos.SwitchEntryServiceStatusSynchronizationPeriodMillis,
+		valueType: schema.TypeInt,
+		validate:  validation.IntAtLeast(5000),
+		value:     func(s *nacos.Switches) interface{} { return int(s.ServiceStatusSynchronizationPeriodMillis) },
+	},
+	{
+		attribute: "server_status_synchronization_period_millis",
+		entry:     nacos.SwitchEntryServerStatusSynchronizationPeriodMillis,
+		valueType: schema.TypeInt,
+		validate:  validation.IntAtLeast(15000),
+		value:     func(s *nacos.Switches) interface{} { return int(s.ServerStatusSynchronizationPeriodMillis) },
+	},
+	{
+		attribute: "push_java_version",
+		entry:     nacos.SwitchEntryPushVersion,
+		client:    nacos.PushClientJava,
+		valueType: schema.TypeString,
+		value:     func(s *nacos.Switches) interface{} { return s.PushJavaVersion },
+	},
+	{
+		attribute: "push_go_version",
+		entry:     nacos.SwitchEntryPushVersion,
+		client:    nacos.PushClientGo,
+		valueType: schema.TypeString,
+		value:     func(s *nacos.Switches) interface{} { return s.PushGoVersion },
+	},
+	{
+		attribute: "push_python_version",
+		entry:     nacos.SwitchEntryPushVersion,
+		client:    nacos.PushClientPython,
+		valueType: schema.TypeString,
+		value:     func(s *nacos.Switches) interface{} { return s.PushPythonVersion },
+	},
+	{
+		attribute: "push_c_version",
+		entry:     nacos.SwitchEntryPushVersion,
+		client:    nacos.PushClientC,
+		valueType: schema.TypeString,
+		value:     func(s *nacos.Switches) interface{} { return s.PushCVersion },
+	},
+	{
+		attribute: "push_csharp_version",
+		entry:     nacos.SwitchEntryPushVersion,
+		client:    nacos.PushClientCSharp,
+		valueType: schema.TypeString,
+		value:     func(s *nacos.Switches) interface{} { return s.PushCSharpVersion },
+	},
+}
+
+// resourceNamingSwitches manages the entries of the naming switches which are set,
+// the others are only read
+func resourceNamingSwitches() *schema.Resource {
+	s := map[string]*schema.Schema{
+		// restore the entries managed by the resource to their value before it on destroy
+		"reset_on_destroy": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		// the values of the managed entries before the resource, by attribute
+		"original_values": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+	for _, sw := range namingSwitches {
+		s[sw.attribute] = &schema.Schema{
+			Type:         sw.valueType,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: sw.validate,
+		}
+	}
+
+	return &schema.Resource{
+		Schema: s,
+
+		CreateContext: resourceNamingSwitchesCreate,
+		ReadContext:   resourceNamingSwitchesRead,
+		UpdateContext: resourceNamingSwitchesUpdate,
+		DeleteContext: resourceNamingSwitchesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNamingSwitchesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(namingSwitchesId)
+
+	if diags := updateNamingSwitches(ctx, d, meta, map[string]interface{}{}); diags.HasError() {
+		return diags
+	}
+
+	return resourceNamingSwitchesRead(ctx, d, meta)
+}
+
+func resourceNamingSwitchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	switches, err := client.GetSwitches(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, sw := range namingSwitches {
+		if err := d.Set(sw.attribute, sw.value(switches)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNamingSwitchesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := updateNamingSwitches(ctx, d, meta, d.Get("original_values").(map[string]interface{})); diags.HasError() {
+		return diags
+	}
+
+	return resourceNamingSwitchesRead(ctx, d, meta)
+}
+
+// resourceNamingSwitchesDelete resets nothing unless reset_on_destroy is set
+func resourceNamingSwitchesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+	if !d.Get("reset_on_destroy").(bool) {
+		return nil
+	}
+
+	switches, err := client.GetSwitches(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	originalValues := d.Get("original_values").(map[string]interface{})
+	for _, sw := range namingSwitches {
+		original, ok := originalValues[sw.attribute].(string)
+		if !ok || original == formatSwitchValue(sw.value(switches)) {
+			continue
+		}
+		if err := client.UpdateSwitch(ctx, sw.entry, sw.entryValue(original)); err != nil {
+			return diag.Errorf("failed to reset switch = %s: %v", sw.entry, err)
+		}
+	}
+
+	return nil
+}
+
+// updateNamingSwitches updates the entries which are set and differ from the current switches,
+// the current value of an entry is recorded in originalValues the first time it is managed
+func updateNamingSwitches(ctx context.Context, d *schema.ResourceData, meta interface{}, originalValues map[string]interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	switches, err := client.GetSwitches(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, sw := range namingSwitches {
+		if d.GetRawConfig().GetAttr(sw.attribute).IsNull() {
+			continue
+		}
+
+		current := formatSwitchValue(sw.value(switches))
+		if _, ok := originalValues[sw.attribute]; !ok {
+			originalValues[sw.attribute] = current
+		}
+		value := formatSwitchValue(d.Get(sw.attribute))
+		if value == current {
+			continue
+		}
+		if err := client.UpdateSwitch(ctx, sw.entry, sw.entryValue(value)); err != nil {
+			return diag.Errorf("failed to update switch = %s: %v", sw.entry, err)
+		}
+	}
+
+	if err := d.Set("original_values", originalValues); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// entryValue is the value sent to the entry, prefixed by the client for the push versions
+func (sw namingSwitch) entryValue(value string) string {
+	if sw.client == "" {
+		return value
+	}
+	return nacos.PushVersionValue(sw.client, value)
+}
+
+func formatSwitchValue(v interface{}) string {
+	switch v := v.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,65 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNacosNamingSwitches_basic(t *testing.T) {
+	var checkTimes int
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNacosConfigurationPreCheck(t)
+			switches, err := testNacosClient.GetSwitches(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkTimes = switches.CheckTimes
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			switches, err := testNacosClient.GetSwitches(context.Background())
+			if err != nil {
+				return err
+			}
+			if switches.CheckTimes != checkTimes {
+				return fmt.Errorf("expected check_times to be reset to %d, got %d", checkTimes, switches.CheckTimes)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosNamingSwitchesConfig(11),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nacos_naming_switches.sample", "check_times", "11"),
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr("nacos_naming_switches.sample", "original_values.check_times", fmt.Sprint(checkTimes))(s)
+					},
+				),
+			},
+			{
+				Config: testAccNacosNamingSwitchesConfig(12),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nacos_naming_switches.sample", "check_times", "12"),
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr("nacos_naming_switches.sample", "original_values.check_times", fmt.Sprint(checkTimes))(s)
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccNacosNamingSwitchesConfig(checkTimes int) string {
+	return fmt.Sprintf(`
+	resource "nacos_naming_switches" "sample" {
+		check_times = %d
+		reset_on_destroy = true
+	}
+	`, checkTimes)
+}
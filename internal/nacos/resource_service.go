@@ -0,0 +1,228 @@
+package nacos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func resourceService() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateNamespace,
+				DiffSuppressFunc: suppressEquivalentNamespace,
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nacos.DefaultGroup,
+				ValidateFunc: validateGroup,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateServiceName,
+			},
+			"protect_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateProtectThreshold,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// read from Nacos when not set, which defaults to none
+			"selector": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(selectorTypes, false),
+						},
+						"expression": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateSelectorExpression,
+							DiffSuppressFunc: suppressEquivalentSelectorExpression,
+						},
+					},
+				},
+			},
+		},
+
+		CreateContext: resourceServiceCreate,
+		ReadContext:   resourceServiceRead,
+		UpdateContext: resourceServiceUpdate,
+		DeleteContext: resourceServiceDelete,
+		CustomizeDiff: resourceServiceCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+var selectorTypes = []string{
+	nacos.SelectorTypeNone,
+	nacos.SelectorTypeLabel,
+}
+
+// resourceServiceCustomizeDiff checks that only label selectors have an expression
+func resourceServiceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if len(d.Get("selector").([]interface{})) == 0 || !d.NewValueKnown("selector") {
+		return nil
+	}
+
+	selectorType := d.Get("selector.0.type").(string)
+	hasExpression := d.Get("selector.0.expression").(string) != ""
+	if selectorType == nacos.SelectorTypeLabel && !hasExpression {
+		return fmt.Errorf("selector.0.expression is required with type %s", nacos.SelectorTypeLabel)
+	}
+	if selectorType != nacos.SelectorTypeLabel && hasExpression {
+		return fmt.Errorf("selector.0.expression is only allowed with type %s", nacos.SelectorTypeLabel)
+	}
+	return nil
+}
+
+func suppressEquivalentSelectorExpression(_, old, new string, _ *schema.ResourceData) bool {
+	oldExpression, err := normalizeSelectorExpression(old)
+	if err != nil {
+		return false
+	}
+	newExpression, err := normalizeSelectorExpression(new)
+	if err != nil {
+		return false
+	}
+	return oldExpression == newExpression
+}
+
+func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	service := expandService(d)
+	if err := client.CreateService(ctx, service); err != nil {
+		return diag.Errorf("failed to create service = %+v: %v", *service, err)
+	}
+
+	d.SetId(convToResourceId(service.Namespace, service.Group, service.Name))
+
+	return resourceServiceRead(ctx, d, meta)
+}
+
+func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	serviceId, err := convToServiceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	service, err := client.GetService(ctx, serviceId)
+	if err != nil {
+		if errors.Is(err, nacos.ErrNotFound) && !d.IsNewResource() {
+			log.Printf("[WARN] service %s does not exist anymore, removing it from the state\n", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	for k, v := range map[string]interface{}{
+		"namespace":         service.Namespace,
+		"group":             service.Group,
+		"name":              service.Name,
+		"protect_threshold": service.ProtectThreshold,
+		"metadata":          service.Metadata,
+		"selector":          flattenSelector(service.Selector),
+	} {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+	if d.HasChanges("protect_threshold", "metadata", "selector") {
+		service := expandService(d)
+		if err := client.UpdateService(ctx, service); err != nil {
+			return diag.Errorf("failed to update service = %+v: %v", *service, err)
+		}
+	}
+
+	return resourceServiceRead(ctx, d, meta)
+}
+
+func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+	serviceId, err := convToServiceId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.DeleteService(ctx, serviceId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandService(d *schema.ResourceData) *nacos.Service {
+	service := &nacos.Service{
+		Namespace:        d.Get("namespace").(string),
+		Group:            d.Get("group").(string),
+		Name:             d.Get("name").(string),
+		ProtectThreshold: d.Get("protect_threshold").(float64),
+		Metadata:         expandStringMap(d.Get("metadata").(map[string]interface{})),
+	}
+	if selectors := d.Get("selector").([]interface{}); len(selectors) > 0 && selectors[0] != nil {
+		selector := selectors[0].(map[string]interface{})
+		service.Selector = &nacos.Selector{Type: selector["type"].(string)}
+		// validated at plan time, only the spacing of the terms changes
+		if expression, err := normalizeSelectorExpression(selector["expression"].(string)); err == nil && service.Selector.Type == nacos.SelectorTypeLabel {
+			service.Selector.Expression = expression
+		}
+	}
+	return service
+}
+
+func flattenSelector(selector *nacos.Selector) []interface{} {
+	if selector == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"type":       selector.Type,
+		"expression": selector.Expression,
+	}}
+}
+
+func expandStringMap(m map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v.(string)
+	}
+	return result
+}
@@ -0,0 +1,304 @@
+package nacos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+var healthCheckerTypes = []string{
+	nacos.HealthCheckerTCP,
+	nacos.HealthCheckerHTTP,
+	nacos.HealthCheckerMySQL,
+	nacos.HealthCheckerNone,
+}
+
+func resourceServiceCluster() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateNamespace,
+				DiffSuppressFunc: suppressEquivalentNamespace,
+			},
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      nacos.DefaultGroup,
+				ValidateFunc: validateGroup,
+			},
+			"service": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateServiceName,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateClusterName,
+			},
+			"check_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      nacos.DefaultCheckPort,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			// check the port of each instance instead of check_port
+			"use_ip_port_for_check": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// read from Nacos when not set, which defaults to TCP
+			"health_checker": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(healthCheckerTypes, false),
+						},
+						"http": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									// in the format of Nacos, e.g. `Host:example.com|User-Agent:nacos`
+									"headers": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"expected_response_code": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      200,
+										ValidateFunc: validation.IntBetween(100, 599),
+									},
+								},
+							},
+						},
+						"mysql": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"password": {
+										Type:      schema.TypeString,
+										Required:  true,
+										Sensitive: true,
+									},
+									"cmd": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CreateContext: resourceServiceClusterCreate,
+		ReadContext:   resourceServiceClusterRead,
+		UpdateContext: resourceServiceClusterUpdate,
+		DeleteContext: resourceServiceClusterDelete,
+		CustomizeDiff: resourceServiceClusterCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// resourceServiceClusterCustomizeDiff checks that the nested blocks match the type of the health checker
+func resourceServiceClusterCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if len(d.Get("health_checker").([]interface{})) == 0 || !d.NewValueKnown("health_checker") {
+		return nil
+	}
+
+	checkerType := d.Get("health_checker.0.type").(string)
+	for block, blockType := range map[string]string{
+		"http":  nacos.HealthCheckerHTTP,
+		"mysql": nacos.HealthCheckerMySQL,
+	} {
+		set := len(d.Get("health_checker.0."+block).([]interface{})) > 0
+		if set && checkerType != blockType {
+			return fmt.Errorf("health_checker.0.%s is only allowed with type %s", block, blockType)
+		}
+		if !set && checkerType == blockType {
+			return fmt.Errorf("health_checker.0.%s is required with type %s", block, blockType)
+		}
+	}
+	return nil
+}
+
+func resourceServiceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	cluster := expandServiceCluster(d)
+	if err := client.UpdateCluster(ctx, cluster); err != nil {
+		return diag.Errorf("failed to create cluster = %s: %v", cluster.Name, err)
+	}
+
+	d.SetId(convToClusterResourceId(cluster.Namespace, cluster.Group, cluster.Service, cluster.Name))
+
+	return resourceServiceClusterRead(ctx, d, meta)
+}
+
+func resourceServiceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+
+	clusterId, err := convToClusterId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cluster, err := client.GetCluster(ctx, clusterId)
+	if err != nil {
+		// the cluster is gone with its service too
+		if errors.Is(err, nacos.ErrNotFound) && !d.IsNewResource() {
+			log.Printf("[WARN] cluster %s does not exist anymore, removing it from the state\n", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	attributes := map[string]interface{}{
+		"namespace":      cluster.Namespace,
+		"group":          cluster.Group,
+		"service":        cluster.Service,
+		"name":           cluster.Name,
+		"metadata":       cluster.Metadata,
+		"health_checker": flattenHealthChecker(cluster.HealthChecker, d),
+	}
+	// not returned by every version of Nacos, kept as in the state otherwise
+	if cluster.CheckPort != nil {
+		attributes["check_port"] = *cluster.CheckPort
+	}
+	if cluster.UseIPPort4Check != nil {
+		attributes["use_ip_port_for_check"] = *cluster.UseIPPort4Check
+	}
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceServiceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*nacos.Client)
+	if d.HasChanges("check_port", "use_ip_port_for_check", "metadata", "health_checker") {
+		cluster := expandServiceCluster(d)
+		if err := client.UpdateCluster(ctx, cluster); err != nil {
+			return diag.Errorf("failed to update cluster = %s: %v", cluster.Name, err)
+		}
+	}
+
+	return resourceServiceClusterRead(ctx, d, meta)
+}
+
+// resourceServiceClusterDelete only forgets the cluster, Nacos does not delete clusters
+func resourceServiceClusterDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func expandServiceCluster(d *schema.ResourceData) *nacos.Cluster {
+	checkPort := d.Get("check_port").(int)
+	useIPPort4Check := d.Get("use_ip_port_for_check").(bool)
+	cluster := &nacos.Cluster{
+		Namespace:       d.Get("namespace").(string),
+		Group:           d.Get("group").(string),
+		Service:         d.Get("service").(string),
+		Name:            d.Get("name").(string),
+		CheckPort:       &checkPort,
+		UseIPPort4Check: &useIPPort4Check,
+		Metadata:        expandStringMap(d.Get("metadata").(map[string]interface{})),
+		HealthChecker:   &nacos.HealthChecker{Type: nacos.HealthCheckerTCP},
+	}
+
+	if raw := d.Get("health_checker").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		checker := raw[0].(map[string]interface{})
+		cluster.HealthChecker.Type = checker["type"].(string)
+		if http := checker["http"].([]interface{}); len(http) > 0 && http[0] != nil {
+			h := http[0].(map[string]interface{})
+			cluster.HealthChecker.Path = h["path"].(string)
+			cluster.HealthChecker.Headers = h["headers"].(string)
+			cluster.HealthChecker.ExpectedResponseCode = h["expected_response_code"].(int)
+		}
+		if mysql := checker["mysql"].([]interface{}); len(mysql) > 0 && mysql[0] != nil {
+			m := mysql[0].(map[string]interface{})
+			cluster.HealthChecker.User = m["user"].(string)
+			cluster.HealthChecker.Password = m["password"].(string)
+			cluster.HealthChecker.Cmd = m["cmd"].(string)
+		}
+	}
+	return cluster
+}
+
+// flattenHealthChecker keeps the MySQL password of the state when Nacos does not return it
+func flattenHealthChecker(checker *nacos.HealthChecker, d *schema.ResourceData) []interface{} {
+	if checker == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"type":  checker.Type,
+		"http":  []interface{}{},
+		"mysql": []interface{}{},
+	}
+	switch checker.Type {
+	case nacos.HealthCheckerHTTP:
+		result["http"] = []interface{}{map[string]interface{}{
+			"path":                   checker.Path,
+			"headers":                checker.Headers,
+			"expected_response_code": checker.ExpectedResponseCode,
+		}}
+	case nacos.HealthCheckerMySQL:
+		password := checker.Password
+		if password == "" {
+			password, _ = d.Get("health_checker.0.mysql.0.password").(string)
+		}
+		result["mysql"] = []interface{}{map[string]interface{}{
+			"user":     checker.User,
+			"password": password,
+			"cmd":      checker.Cmd,
+		}}
+	}
+	return []interface{}{result}
+}
@@ -0,0 +1,123 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNacosServiceCluster_basic(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosServiceClusterConfig(rName, `
+					type = "HTTP"
+					http {
+						path = "/health"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosServiceClusterExists("sample"),
+					resource.TestCheckResourceAttr("nacos_service_cluster.sample", "health_checker.0.type", "HTTP"),
+					resource.TestCheckResourceAttr("nacos_service_cluster.sample", "health_checker.0.http.0.path", "/health"),
+				),
+			},
+			{
+				Config: testAccNacosServiceClusterConfig(rName, `
+					type = "TCP"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosServiceClusterExists("sample"),
+					resource.TestCheckResourceAttr("nacos_service_cluster.sample", "health_checker.0.type", "TCP"),
+				),
+			},
+			{
+				Config: testAccNacosServiceClusterConfig(rName, `
+					type = "TCP"
+					http {
+						path = "/health"
+					}
+				`),
+				ExpectError: regexp.MustCompile("only allowed with type HTTP"),
+			},
+		},
+	})
+}
+
+func TestAccNacosServiceCluster_disappears(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosServiceClusterConfig(rName, `
+					type = "TCP"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosServiceClusterExists("sample"),
+					func(s *terraform.State) error {
+						serviceId, err := convToServiceId(s.RootModule().Resources["nacos_service.sample"].Primary.ID)
+						if err != nil {
+							return err
+						}
+						return testNacosClient.DeleteService(context.Background(), serviceId)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccNacosServiceClusterConfig(rName, healthChecker string) string {
+	return fmt.Sprintf(`
+	resource "nacos_service" "sample" {
+		namespace = "%s"
+		group = "%s"
+		name = "%s"
+	}
+
+	resource "nacos_service_cluster" "sample" {
+		namespace = nacos_service.sample.namespace
+		group = nacos_service.sample.group
+		service = nacos_service.sample.name
+		name = "DEFAULT"
+		check_port = 8080
+		use_ip_port_for_check = false
+
+		health_checker {
+			%s
+		}
+	}
+	`, _namespace1, _group1, rName, healthChecker)
+}
+
+func testAccCheckNacosServiceClusterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[fmt.Sprintf("nacos_service_cluster.%s", resourceName)]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		clusterId, err := convToClusterId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error in splitting cluster ID: %v", err)
+		}
+
+		_, err = testNacosClient.GetCluster(context.Background(), clusterId)
+		return err
+	}
+}
@@ -0,0 +1,170 @@
+package nacos
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccNacosService_basic(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosServiceConfig(rName, 0.5, "team-a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosServiceExists("sample"),
+					resource.TestCheckResourceAttr("nacos_service.sample", "protect_threshold", "0.5"),
+					resource.TestCheckResourceAttr("nacos_service.sample", "metadata.owner", "team-a"),
+				),
+			},
+			{
+				Config: testAccNacosServiceConfig(rName, 0.8, "team-b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosServiceExists("sample"),
+					resource.TestCheckResourceAttr("nacos_service.sample", "protect_threshold", "0.8"),
+					resource.TestCheckResourceAttr("nacos_service.sample", "metadata.owner", "team-b"),
+				),
+			},
+			{
+				ResourceName:      "nacos_service.sample",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccNacosService_disappears(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNacosServiceConfig(rName, 0, "team-a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosServiceExists("sample"),
+					func(s *terraform.State) error {
+						serviceId, err := convToServiceId(s.RootModule().Resources["nacos_service.sample"].Primary.ID)
+						if err != nil {
+							return err
+						}
+						return testNacosClient.DeleteService(context.Background(), serviceId)
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccNacosService_selector(t *testing.T) {
+	rName := fmt.Sprintf("service-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccNacosConfigurationPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckNacosServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNacosServiceSelectorConfig(rName, "CONSUMER.label.zone = PROVIDER.lable.zone"),
+				ExpectError: regexp.MustCompile("must be like"),
+			},
+			{
+				Config: testAccNacosServiceSelectorConfig(rName, "CONSUMER.label.zone=PROVIDER.label.zone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNacosServiceExists("sample"),
+					resource.TestCheckResourceAttr("nacos_service.sample", "selector.0.type", "label"),
+				),
+			},
+			{
+				ResourceName:            "nacos_service.sample",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"selector.0.expression"},
+			},
+		},
+	})
+}
+
+func testAccNacosServiceSelectorConfig(rName, expression string) string {
+	return fmt.Sprintf(`
+	resource "nacos_service" "sample" {
+		namespace = "%s"
+		group = "%s"
+		name = "%s"
+
+		selector {
+			type = "label"
+			expression = "%s"
+		}
+	}
+	`, _namespace1, _group1, rName, expression)
+}
+
+func testAccNacosServiceConfig(rName string, protectThreshold float64, owner string) string {
+	return fmt.Sprintf(`
+	resource "nacos_service" "sample" {
+		namespace = "%s"
+		group = "%s"
+		name = "%s"
+		protect_threshold = %v
+
+		metadata = {
+			owner = "%s"
+		}
+	}
+	`, _namespace1, _group1, rName, protectThreshold, owner)
+}
+
+func testAccCheckNacosServiceDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nacos_service" {
+			continue
+		}
+
+		serviceId, err := convToServiceId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, err = testNacosClient.GetService(context.Background(), serviceId)
+		if err == nil {
+			return fmt.Errorf("service %+v still exists", *serviceId)
+		}
+		if !strings.Contains(err.Error(), "not found service") {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNacosServiceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[fmt.Sprintf("nacos_service.%s", resourceName)]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		serviceId, err := convToServiceId(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error in splitting service ID: %v", err)
+		}
+
+		_, err = testNacosClient.GetService(context.Background(), serviceId)
+		return err
+	}
+}
@@ -2,8 +2,11 @@ package nacos
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
 	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
 )
 
@@ -11,18 +14,132 @@ const (
 	ConfigurationIdSeparator = "/"
 )
 
-func convToConfigurationId(resourceId string) (*nacos.ConfigurationId, error) {
+var (
+	// only the separator and the escape character are escaped, so that IDs stay readable
+	idPartEscaper   = strings.NewReplacer("%", "%25", ConfigurationIdSeparator, "%2F")
+	idPartUnescaper = strings.NewReplacer("%2F", ConfigurationIdSeparator, "%2f", ConfigurationIdSeparator, "%25", "%")
+)
+
+// joinResourceId joins identifiers with the separator, escaping it inside of them.
+// Configuration identifiers never contain it, see validateIdentifier, but service names may:
+// a service `team/payment` becomes `team%2Fpayment`
+func joinResourceId(parts ...string) string {
+	escaped := make([]string, len(parts))
+	for i, part := range parts {
+		escaped[i] = idPartEscaper.Replace(part)
+	}
+	return strings.Join(escaped, ConfigurationIdSeparator)
+}
+
+// splitResourceId is the reverse of joinResourceId, the ID must have exactly n parts
+func splitResourceId(resourceId string, n int) ([]string, error) {
 	parts := strings.Split(resourceId, ConfigurationIdSeparator)
-	if len(parts) != 3 {
+	if len(parts) != n {
 		return nil, fmt.Errorf("invalid resourceId: %s", resourceId)
 	}
+	for i, part := range parts {
+		parts[i] = idPartUnescaper.Replace(part)
+	}
+	return parts, nil
+}
+
+// namespaceIdPart spells the public namespace `public` in IDs, whether it was given as `public` or empty
+func namespaceIdPart(namespace string) string {
+	if namespace == "" {
+		return nacos.PublicNamespace
+	}
+	return namespace
+}
+
+func convToConfigurationId(resourceId string) (*nacos.ConfigurationId, error) {
+	parts, err := splitResourceId(resourceId, 3)
+	if err != nil {
+		return nil, err
+	}
 	return &nacos.ConfigurationId{
-		Namespace: parts[0],
+		Namespace: nacos.NormalizeNamespace(parts[0]),
 		Group:     parts[1],
 		Key:       parts[2],
 	}, nil
 }
 
 func convToResourceId(namespace, group, key string) string {
-	return strings.Join([]string{namespace, group, key}, ConfigurationIdSeparator)
+	return joinResourceId(namespaceIdPart(namespace), group, key)
+}
+
+func convToGroupId(resourceId string) (namespace, group string, err error) {
+	parts, err := splitResourceId(resourceId, 2)
+	if err != nil {
+		return "", "", err
+	}
+	return nacos.NormalizeNamespace(parts[0]), parts[1], nil
+}
+
+func convToGroupResourceId(namespace, group string) string {
+	return joinResourceId(namespaceIdPart(namespace), group)
+}
+
+func convToServiceId(resourceId string) (*nacos.ServiceId, error) {
+	parts, err := splitResourceId(resourceId, 3)
+	if err != nil {
+		return nil, err
+	}
+	return &nacos.ServiceId{
+		Namespace: nacos.NormalizeNamespace(parts[0]),
+		Group:     parts[1],
+		Name:      parts[2],
+	}, nil
+}
+
+func convToInstanceId(resourceId string) (*nacos.InstanceId, error) {
+	parts, err := splitResourceId(resourceId, 5)
+	if err != nil {
+		return nil, err
+	}
+
+	// the IP may be IPv6, the port follows the last colon
+	i := strings.LastIndex(parts[4], ":")
+	if i < 0 {
+		return nil, fmt.Errorf("invalid resourceId: %s", resourceId)
+	}
+	port, err := strconv.Atoi(parts[4][i+1:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid resourceId: %s", resourceId)
+	}
+	return &nacos.InstanceId{
+		Namespace: nacos.NormalizeNamespace(parts[0]),
+		Group:     parts[1],
+		Service:   parts[2],
+		Cluster:   parts[3],
+		Ip:        parts[4][:i],
+		Port:      port,
+	}, nil
+}
+
+func convToInstanceResourceId(instanceId *nacos.InstanceId) string {
+	return joinResourceId(
+		namespaceIdPart(instanceId.Namespace), instanceId.Group, instanceId.Service, instanceId.Cluster,
+		fmt.Sprintf("%s:%d", instanceId.Ip, instanceId.Port))
+}
+
+func convToClusterId(resourceId string) (*nacos.ClusterId, error) {
+	parts, err := splitResourceId(resourceId, 4)
+	if err != nil {
+		return nil, err
+	}
+	return &nacos.ClusterId{
+		Namespace: nacos.NormalizeNamespace(parts[0]),
+		Group:     parts[1],
+		Service:   parts[2],
+		Name:      parts[3],
+	}, nil
+}
+
+func convToClusterResourceId(namespace, group, service, name string) string {
+	return joinResourceId(namespaceIdPart(namespace), group, service, name)
+}
+
+// suppressEquivalentNamespace hides the difference between `public` and the empty namespace
+func suppressEquivalentNamespace(_, old, new string, _ *schema.ResourceData) bool {
+	return nacos.NormalizeNamespace(old) == nacos.NormalizeNamespace(new)
 }
@@ -0,0 +1,125 @@
+package nacos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	nacos "github.com/zalopay-oss/terraform-provider-nacos/pkg/client"
+)
+
+func TestConvToConfigurationId(t *testing.T) {
+	testcases := []struct {
+		name       string
+		id         nacos.ConfigurationId
+		resourceId string
+	}{
+		{
+			name:       "plain",
+			id:         nacos.ConfigurationId{Namespace: "sandbox", Group: "GROUP", Key: "app.yaml"},
+			resourceId: "sandbox/GROUP/app.yaml",
+		},
+		{
+			name:       "empty public namespace",
+			id:         nacos.ConfigurationId{Namespace: "", Group: "DEFAULT_GROUP", Key: "app.yaml"},
+			resourceId: "public/DEFAULT_GROUP/app.yaml",
+		},
+		{
+			name:       "empty key",
+			id:         nacos.ConfigurationId{Namespace: "sandbox", Group: "GROUP", Key: ""},
+			resourceId: "sandbox/GROUP/",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceId := convToResourceId(tc.id.Namespace, tc.id.Group, tc.id.Key)
+			assert.Equal(t, tc.resourceId, resourceId)
+
+			id, err := convToConfigurationId(resourceId)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.id, *id)
+		})
+	}
+
+	// the public namespace is the empty tenant, whatever its spelling in the ID
+	for _, resourceId := range []string{"public/DEFAULT_GROUP/app.yaml", "/DEFAULT_GROUP/app.yaml"} {
+		id, err := convToConfigurationId(resourceId)
+		assert.Nil(t, err)
+		assert.Equal(t, "", id.Namespace)
+	}
+
+	for _, resourceId := range []string{"", "sandbox/GROUP", "sandbox/team/a/app.yaml"} {
+		_, err := convToConfigurationId(resourceId)
+		assert.NotNil(t, err, resourceId)
+	}
+}
+
+// service names may contain the separator, unlike configuration identifiers
+func TestConvToServiceId(t *testing.T) {
+	testcases := []struct {
+		name       string
+		id         nacos.ServiceId
+		resourceId string
+	}{
+		{
+			name:       "plain",
+			id:         nacos.ServiceId{Namespace: "sandbox", Group: "GROUP", Name: "payment"},
+			resourceId: "sandbox/GROUP/payment",
+		},
+		{
+			name:       "slash",
+			id:         nacos.ServiceId{Namespace: "sandbox", Group: "GROUP", Name: "team/payment"},
+			resourceId: "sandbox/GROUP/team%2Fpayment",
+		},
+		{
+			name:       "escape character",
+			id:         nacos.ServiceId{Namespace: "sandbox", Group: "GROUP", Name: "100%2F/%25"},
+			resourceId: "sandbox/GROUP/100%252F%2F%2525",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			resourceId := convToResourceId(tc.id.Namespace, tc.id.Group, tc.id.Name)
+			assert.Equal(t, tc.resourceId, resourceId)
+
+			id, err := convToServiceId(resourceId)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.id, *id)
+		})
+	}
+}
+
+func TestConvToGroupId(t *testing.T) {
+	resourceId := convToGroupResourceId("", "TEAM_A")
+	assert.Equal(t, "public/TEAM_A", resourceId)
+
+	namespace, group, err := convToGroupId(resourceId)
+	assert.Nil(t, err)
+	assert.Equal(t, "", namespace)
+	assert.Equal(t, "TEAM_A", group)
+
+	_, _, err = convToGroupId("a/b/c")
+	assert.NotNil(t, err)
+}
+
+func TestConvToInstanceId(t *testing.T) {
+	for _, instanceId := range []nacos.InstanceId{
+		{Namespace: "sandbox", Group: "GROUP", Service: "mysql", Cluster: "DEFAULT", Ip: "10.0.0.1", Port: 3306},
+		{Namespace: "", Group: "DEFAULT_GROUP", Service: "mysql", Cluster: "DEFAULT", Ip: "fe80::1", Port: 3306},
+	} {
+		id, err := convToInstanceId(convToInstanceResourceId(&instanceId))
+		assert.Nil(t, err)
+		assert.Equal(t, instanceId, *id)
+	}
+
+	assert.Equal(t, "public/DEFAULT_GROUP/mysql/DEFAULT/10.0.0.1:3306", convToInstanceResourceId(&nacos.InstanceId{
+		Group: "DEFAULT_GROUP", Service: "mysql", Cluster: "DEFAULT", Ip: "10.0.0.1", Port: 3306,
+	}))
+
+	for _, resourceId := range []string{"", "public/DEFAULT_GROUP/mysql/DEFAULT/10.0.0.1", "public/DEFAULT_GROUP/mysql/DEFAULT/10.0.0.1:port"} {
+		_, err := convToInstanceId(resourceId)
+		assert.NotNil(t, err, resourceId)
+	}
+}
@@ -0,0 +1,167 @@
+package nacos
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// limits enforced by the server, see ParamUtils and the size of the database columns
+const (
+	maxKeyLength         = 255
+	maxGroupLength       = 128
+	maxNamespaceLength   = 128
+	maxDescriptionLength = 128
+	maxTagCount          = 5
+	maxTagLength         = 64
+	maxContentSize       = 10 * 1024 * 1024
+)
+
+var (
+	validateKey         = validateIdentifier(maxKeyLength, false)
+	validateGroup       = validateIdentifier(maxGroupLength, false)
+	validateNamespace   = validateIdentifier(maxNamespaceLength, true)
+	validateDescription = validation.StringLenBetween(0, maxDescriptionLength)
+	validateTag         = validation.StringLenBetween(1, maxTagLength)
+
+	validateProtectThreshold = validation.FloatBetween(0, 1)
+)
+
+// isValidIdentifier accepts letters, digits and `_-.:`, exactly like ParamUtils.isValid in Nacos 1.x and 2.x,
+// which rejects the `/` separator of resource IDs
+func isValidIdentifier(s string) bool {
+	for _, c := range s {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && !strings.ContainsRune("_-.:", c) {
+			return false
+		}
+	}
+	return true
+}
+
+func validateIdentifier(maxLength int, allowEmpty bool) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		if v == "" {
+			if allowEmpty {
+				return nil, nil
+			}
+			return nil, []error{fmt.Errorf("%s must not be empty", k)}
+		}
+		if len([]rune(v)) > maxLength {
+			return nil, []error{fmt.Errorf("%s must be at most %d characters, got %d", k, maxLength, len([]rune(v)))}
+		}
+		if !isValidIdentifier(v) {
+			return nil, []error{fmt.Errorf("%s must only contain letters, digits and `_-.:`, got %q", k, v)}
+		}
+		return nil, nil
+	}
+}
+
+// validateServiceName rejects the `@@` separator of grouped service names, like NamingUtils
+func validateServiceName(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if strings.TrimSpace(v) == "" {
+		return nil, []error{fmt.Errorf("%s must not be blank", k)}
+	}
+	if strings.Contains(v, "@@") {
+		return nil, []error{fmt.Errorf("%s must not contain `@@`, got %q", k, v)}
+	}
+	return nil, nil
+}
+
+// validateClusterName accepts letters, digits and `-`, like the cluster name syntax of Nacos
+var validateClusterName = validation.StringMatch(regexp.MustCompile(`^[0-9a-zA-Z-]+$`), "must only contain letters, digits and `-`")
+
+// validateContent rejects blank content and content over the size limit of the server
+func validateContent(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if err := checkContent(v); err != nil {
+		return nil, []error{fmt.Errorf("%s %v", k, err)}
+	}
+	return nil, nil
+}
+
+func checkContent(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return fmt.Errorf("must not be blank")
+	}
+	if len(content) > maxContentSize {
+		return fmt.Errorf("must be at most %d bytes, got %d", maxContentSize, len(content))
+	}
+	return nil
+}
+
+// the terms of a label selector expression, e.g. `CONSUMER.label.zone = PROVIDER.label.zone`
+const (
+	consumerLabelPrefix = "CONSUMER.label."
+	providerLabelPrefix = "PROVIDER.label."
+)
+
+// validateSelectorExpression accepts the expressions of label selectors, like LabelSelector.parseExpression
+func validateSelectorExpression(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if v == "" {
+		return nil, nil
+	}
+	if _, err := normalizeSelectorExpression(v); err != nil {
+		return nil, []error{fmt.Errorf("%s %v", k, err)}
+	}
+	return nil, nil
+}
+
+// normalizeSelectorExpression parses the `&` separated terms of a label selector expression,
+// each of them must match a label of the consumer to the same label of the provider.
+// The terms are rendered as `CONSUMER.label.<label> = PROVIDER.label.<label>` joined with ` & `
+func normalizeSelectorExpression(expression string) (string, error) {
+	terms := strings.Split(expression, "&")
+	for i, term := range terms {
+		sides := strings.Split(term, "=")
+		if len(sides) != 2 {
+			return "", fmt.Errorf("term %q must be like `%s<label> = %s<label>`", strings.TrimSpace(term), consumerLabelPrefix, providerLabelPrefix)
+		}
+
+		consumer, provider := strings.TrimSpace(sides[0]), strings.TrimSpace(sides[1])
+		if !strings.HasPrefix(consumer, consumerLabelPrefix) || !strings.HasPrefix(provider, providerLabelPrefix) {
+			return "", fmt.Errorf("term %q must be like `%s<label> = %s<label>`", strings.TrimSpace(term), consumerLabelPrefix, providerLabelPrefix)
+		}
+		label := strings.TrimPrefix(consumer, consumerLabelPrefix)
+		if label == "" || strings.ContainsAny(label, " \t\n") {
+			return "", fmt.Errorf("term %q has an invalid label %q", strings.TrimSpace(term), label)
+		}
+		if providerLabel := strings.TrimPrefix(provider, providerLabelPrefix); providerLabel != label {
+			return "", fmt.Errorf("term %q must match the same label of the consumer and the provider, got %q and %q", strings.TrimSpace(term), label, providerLabel)
+		}
+
+		terms[i] = consumer + " = " + provider
+	}
+	return strings.Join(terms, " & "), nil
+}
+
+func validateDuration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := time.ParseDuration(v); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a duration like `30s` or `5m`: %v", k, err)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,75 @@
+package nacos
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidators(t *testing.T) {
+	testcases := []struct {
+		name      string
+		validate  schema.SchemaValidateFunc
+		value     string
+		expectErr bool
+	}{
+		{name: "key", validate: validateKey, value: "service-a.app_config:v1"},
+		{name: "unicode key", validate: validateKey, value: "cấu-hình"},
+		{name: "empty key", validate: validateKey, value: "", expectErr: true},
+		{name: "key with slash", validate: validateKey, value: "service/app.yaml", expectErr: true},
+		{name: "key with at sign", validate: validateKey, value: "app@v1", expectErr: true},
+		{name: "group with slash", validate: validateGroup, value: "team/a", expectErr: true},
+		{name: "key with space", validate: validateKey, value: "app yaml", expectErr: true},
+		{name: "long key", validate: validateKey, value: strings.Repeat("k", maxKeyLength)},
+		{name: "too long key", validate: validateKey, value: strings.Repeat("k", maxKeyLength+1), expectErr: true},
+		{name: "group", validate: validateGroup, value: "DEFAULT_GROUP"},
+		{name: "empty group", validate: validateGroup, value: "", expectErr: true},
+		{name: "too long group", validate: validateGroup, value: strings.Repeat("g", maxGroupLength+1), expectErr: true},
+		{name: "public namespace", validate: validateNamespace, value: ""},
+		{name: "namespace", validate: validateNamespace, value: "8f1c3a2e-1b7d-4c55-9d0e-4f6a7b8c9d0e"},
+		{name: "invalid namespace", validate: validateNamespace, value: "team#a", expectErr: true},
+		{name: "too long namespace", validate: validateNamespace, value: strings.Repeat("n", maxNamespaceLength+1), expectErr: true},
+		{name: "service name", validate: validateServiceName, value: "payment-service"},
+		{name: "grouped service name", validate: validateServiceName, value: "GROUP@@payment-service", expectErr: true},
+		{name: "cluster name", validate: validateClusterName, value: "DEFAULT"},
+		{name: "invalid cluster name", validate: validateClusterName, value: "zone_a", expectErr: true},
+		{name: "content", validate: validateContent, value: "key: value"},
+		{name: "blank content", validate: validateContent, value: " \n\t", expectErr: true},
+		{name: "too large content", validate: validateContent, value: strings.Repeat("c", maxContentSize+1), expectErr: true},
+		{name: "too long description", validate: validateDescription, value: strings.Repeat("d", maxDescriptionLength+1), expectErr: true},
+		{name: "duration", validate: validateDuration, value: "1m30s"},
+		{name: "invalid duration", validate: validateDuration, value: "5 minutes", expectErr: true},
+		{name: "selector expression", validate: validateSelectorExpression, value: "CONSUMER.label.zone = PROVIDER.label.zone & CONSUMER.label.site=PROVIDER.label.site"},
+		{name: "selector expression with typo", validate: validateSelectorExpression, value: "CONSUMER.lable.zone = PROVIDER.label.zone", expectErr: true},
+		{name: "selector expression with different labels", validate: validateSelectorExpression, value: "CONSUMER.label.zone = PROVIDER.label.site", expectErr: true},
+		{name: "selector expression without provider", validate: validateSelectorExpression, value: "CONSUMER.label.zone", expectErr: true},
+		{name: "selector expression with empty term", validate: validateSelectorExpression, value: "CONSUMER.label.zone = PROVIDER.label.zone &", expectErr: true},
+		{name: "too long tag", validate: validateTag, value: strings.Repeat("t", maxTagLength+1), expectErr: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, errs := tc.validate(tc.value, "attribute")
+			if tc.expectErr {
+				assert.NotEmpty(t, errs)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestValidateProtectThreshold(t *testing.T) {
+	for v, expectErr := range map[float64]bool{0: false, 0.5: false, 1: false, -0.1: true, 1.5: true} {
+		_, errs := validateProtectThreshold(v, "protect_threshold")
+		assert.Equal(t, expectErr, len(errs) > 0, "protect_threshold = %v", v)
+	}
+}
+
+func TestNormalizeSelectorExpression(t *testing.T) {
+	expression, err := normalizeSelectorExpression("CONSUMER.label.zone=PROVIDER.label.zone&  CONSUMER.label.site =  PROVIDER.label.site")
+	assert.Nil(t, err)
+	assert.Equal(t, "CONSUMER.label.zone = PROVIDER.label.zone & CONSUMER.label.site = PROVIDER.label.site", expression)
+}
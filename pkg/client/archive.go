@@ -0,0 +1,128 @@
+package client
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	archiveMetaFileV1 = ".meta.yml"
+	archiveMetaFileV2 = ".metadata.yml"
+)
+
+type archiveMetadataV2 struct {
+	Metadata []ArchiveItem `yaml:"metadata"`
+}
+
+// ParseConfigurationArchive lists the items of an archive produced by ExportConfigurations.
+// Both archive layouts store every item at `<group>/<dataId>`, next to an optional metadata file:
+// the legacy layout only writes `.meta.yml` when an item has an appName.
+func ParseConfigurationArchive(data []byte) ([]ArchiveItem, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %v", err)
+	}
+
+	var (
+		items  []ArchiveItem
+		appsV1 map[string]string
+		metaV2 = map[string]ArchiveItem{}
+	)
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		switch f.Name {
+		case archiveMetaFileV1:
+			content, err := readArchiveFile(f)
+			if err != nil {
+				return nil, err
+			}
+			appsV1 = parseArchiveMetadataV1(content)
+
+		case archiveMetaFileV2:
+			content, err := readArchiveFile(f)
+			if err != nil {
+				return nil, err
+			}
+			var meta archiveMetadataV2
+			if err := yaml.Unmarshal(content, &meta); err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %v", archiveMetaFileV2, err)
+			}
+			for _, item := range meta.Metadata {
+				metaV2[item.Group+"/"+item.Key] = item
+			}
+
+		default:
+			parts := strings.SplitN(f.Name, "/", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return nil, fmt.Errorf("unexpected archive entry: %s", f.Name)
+			}
+			items = append(items, ArchiveItem{
+				Group: parts[0],
+				Key:   parts[1],
+				Size:  int(f.UncompressedSize64),
+			})
+		}
+	}
+	for i := range items {
+		if meta, ok := metaV2[items[i].Group+"/"+items[i].Key]; ok {
+			items[i].Type = meta.Type
+			items[i].Description = meta.Description
+			items[i].AppName = meta.AppName
+		}
+		if appName, ok := appsV1[archiveMetaKeyV1(items[i].Group, items[i].Key)]; ok {
+			items[i].AppName = appName
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Group != items[j].Group {
+			return items[i].Group < items[j].Group
+		}
+		return items[i].Key < items[j].Key
+	})
+	return items, nil
+}
+
+func readArchiveFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive entry %s: %v", f.Name, err)
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
+
+// parseArchiveMetadataV1 reads the `<group>.<dataId>.app=<appName>` lines of the legacy layout.
+func parseArchiveMetadataV1(content []byte) map[string]string {
+	apps := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		idx := strings.Index(line, "=")
+		if idx <= 0 {
+			continue
+		}
+		apps[line[:idx]] = line[idx+1:]
+	}
+	return apps
+}
+
+// archiveMetaKeyV1 mirrors how Nacos names an item in `.meta.yml`:
+// the last dot of the dataId is replaced by `~`.
+func archiveMetaKeyV1(group, key string) string {
+	if idx := strings.LastIndex(key, "."); idx >= 0 {
+		key = key[:idx] + "~" + key[idx+1:]
+	}
+	return group + "." + key + ".app"
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func buildArchive(t *testing.T, files map[string]string) []byte {
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		assert.Nil(t, err)
+		_, err = f.Write([]byte(content))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestParseConfigurationArchive(t *testing.T) {
+	testcases := []struct {
+		name   string
+		data   []byte
+		expect []ArchiveItem
+		err    bool
+	}{
+		{
+			name: "not an archive",
+			data: []byte("not a zip"),
+			err:  true,
+		},
+		{
+			name: "v1 without metadata",
+			data: buildArchive(t, map[string]string{
+				"GROUP/key":      "value",
+				"OTHER/app.yaml": "a: 1",
+			}),
+			expect: []ArchiveItem{
+				{Group: "GROUP", Key: "key", Size: 5},
+				{Group: "OTHER", Key: "app.yaml", Size: 4},
+			},
+		},
+		{
+			name: "v1",
+			data: buildArchive(t, map[string]string{
+				"GROUP/app.yaml":  "a: 1",
+				"GROUP/plain":     "value",
+				archiveMetaFileV1: "GROUP.app~yaml.app=billing\r\n",
+			}),
+			expect: []ArchiveItem{
+				{Group: "GROUP", Key: "app.yaml", AppName: "billing", Size: 4},
+				{Group: "GROUP", Key: "plain", Size: 5},
+			},
+		},
+		{
+			name: "v2",
+			data: buildArchive(t, map[string]string{
+				"B_GROUP/key":     "value",
+				"A_GROUP/app.yml": "a: 1",
+				archiveMetaFileV2: `metadata:
+- dataId: app.yml
+  group: A_GROUP
+  type: yaml
+  desc: application config
+  appName: billing
+- dataId: key
+  group: B_GROUP
+  type: text
+`,
+			}),
+			expect: []ArchiveItem{
+				{Group: "A_GROUP", Key: "app.yml", Type: "yaml", Description: "application config", AppName: "billing", Size: 4},
+				{Group: "B_GROUP", Key: "key", Type: "text", Size: 5},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			items, err := ParseConfigurationArchive(tc.data)
+			if tc.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expect, items)
+		})
+	}
+}
@@ -2,32 +2,82 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 )
 
+// ErrNotFound is wrapped by the errors of the lookups which did not find the requested object
+var ErrNotFound = errors.New("not found")
+
 type Config struct {
 	Address     string
 	Username    string
 	Password    string
 	ContextPath string
+	Encryptors  []Encryptor
 }
 
 type Client struct {
 	user        *loginParams
 	baseURL     string
 	accessToken *cString
+	encryptors  map[string]Encryptor
 }
 
 const (
 	DefaultContextPath = "nacos"
 	ShowAll            = "all"
 
-	LoginPath         = "auth/login"
-	ConfigurationPath = "cs/configs"
+	// PublicNamespace is the namespace of configurations published without tenant
+	PublicNamespace = "public"
+	DefaultGroup    = "DEFAULT_GROUP"
+
+	ConfigurationTypeText       = "text"
+	ConfigurationTypeJSON       = "json"
+	ConfigurationTypeXML        = "xml"
+	ConfigurationTypeYAML       = "yaml"
+	ConfigurationTypeHTML       = "html"
+	ConfigurationTypeProperties = "properties"
+
+	ExportFormatV1 = "v1"
+	ExportFormatV2 = "v2"
+
+	SearchAccurate        = "accurate"
+	SearchBlur            = "blur"
+	DefaultSearchPageSize = 100
+
+	DefaultDeleteBatchSize = 50
+
+	PolicyAbort     = "ABORT"
+	PolicySkip      = "SKIP"
+	PolicyOverwrite = "OVERWRITE"
+
+	LoginPath                 = "auth/login"
+	ConfigurationPath         = "cs/configs"
+	ConfigurationListenerPath = "cs/configs/listener"
+	ListenerPath              = "cs/listener"
 )
 
+// NormalizeNamespace returns the empty tenant for the public namespace, both designate the same configurations
+func NormalizeNamespace(namespace string) string {
+	if namespace == PublicNamespace {
+		return ""
+	}
+	return namespace
+}
+
+func groupOrDefault(group string) string {
+	if group == "" {
+		return DefaultGroup
+	}
+	return group
+}
+
 func NewClient(cfg *Config) (*Client, error) {
 	contextPath := DefaultContextPath
 	if cfg.ContextPath != "" {
@@ -41,6 +91,10 @@ func NewClient(cfg *Config) (*Client, error) {
 		},
 		baseURL:     fmt.Sprintf("%s/%s/v1/", cfg.Address, contextPath),
 		accessToken: &cString{},
+		encryptors:  map[string]Encryptor{},
+	}
+	for _, encryptor := range cfg.Encryptors {
+		client.encryptors[encryptor.Algorithm()] = encryptor
 	}
 
 	if err := client.login(); err != nil {
@@ -98,8 +152,8 @@ func (c *Client) GetConfiguration(ctx context.Context, params *ConfigurationId)
 		ctx, http.MethodGet, c.baseURL+ConfigurationPath, &resp,
 		withAuthentication(c.accessToken),
 		withQuery(
-			"tenant", params.Namespace,
-			"group", params.Group,
+			"tenant", NormalizeNamespace(params.Namespace),
+			"group", groupOrDefault(params.Group),
 			"dataId", params.Key,
 			"show", ShowAll))
 	if err != nil {
@@ -107,23 +161,56 @@ func (c *Client) GetConfiguration(ctx context.Context, params *ConfigurationId)
 	}
 	if resp == (Configuration{}) {
 		log.Printf("[WARN] not found configration=%+v\n", params)
-		return nil, fmt.Errorf("not found configuration=%+v", *params)
+		return nil, fmt.Errorf("%w configuration=%+v", ErrNotFound, *params)
+	}
+
+	if resp.Value, err = c.decrypt(resp.Key, resp.Value, resp.EncryptedDataKey); err != nil {
+		return nil, fmt.Errorf("get configuration error: %v", err)
 	}
 
 	return &resp, nil
 }
 
+// ConfigurationTypes are the content types known by Nacos
+var ConfigurationTypes = []string{
+	ConfigurationTypeText,
+	ConfigurationTypeJSON,
+	ConfigurationTypeXML,
+	ConfigurationTypeYAML,
+	ConfigurationTypeHTML,
+	ConfigurationTypeProperties,
+}
+
+// PublishConfiguration creates or updates a configuration,
+// the content of cipher-prefixed keys is encrypted when an Encryptor is registered for their algorithm.
 func (c *Client) PublishConfiguration(ctx context.Context, params *Configuration) error {
+	content, encryptedDataKey, err := c.encrypt(params.Key, params.Value)
+	if err != nil {
+		return fmt.Errorf("publish configuration error: %v", err)
+	}
+
+	form := []string{
+		"tenant", NormalizeNamespace(params.Namespace),
+		"group", groupOrDefault(params.Group),
+		"dataId", params.Key,
+		"content", content,
+		"desc", params.Description,
+	}
+	if encryptedDataKey != "" {
+		form = append(form, "encryptedDataKey", encryptedDataKey)
+	}
+	if params.Type != "" {
+		form = append(form, "type", params.Type)
+	}
+	if params.Tags != "" {
+		form = append(form, "config_tags", params.Tags)
+	}
+
 	var resp bool
-	err := c.request(
+	err = c.request(
 		ctx, http.MethodPost, c.baseURL+ConfigurationPath, &resp,
 		withAuthentication(c.accessToken),
-		withForm(
-			"tenant", params.Namespace,
-			"group", params.Group,
-			"dataId", params.Key,
-			"content", params.Value,
-			"desc", params.Description))
+		withForm(form...))
 	if err != nil {
 		return fmt.Errorf("publish configuration error: %+v", err)
 	}
@@ -137,8 +224,8 @@ func (c *Client) DeleteConfiguration(ctx context.Context, params *ConfigurationI
 		ctx, http.MethodDelete, c.baseURL+ConfigurationPath, &resp,
 		withAuthentication(c.accessToken),
 		withQuery(
-			"tenant", params.Namespace,
-			"group", params.Group,
+			"tenant", NormalizeNamespace(params.Namespace),
+			"group", groupOrDefault(params.Group),
 			"dataId", params.Key))
 	if err != nil {
 		return false, fmt.Errorf("delete configuration error: %v", err)
@@ -146,3 +233,288 @@ func (c *Client) DeleteConfiguration(ctx context.Context, params *ConfigurationI
 
 	return true, nil
 }
+
+// DeleteConfigurations deletes configurations by their Nacos IDs with the batch `delType=ids` endpoint.
+// IDs are sent in chunks of DefaultDeleteBatchSize, the failed chunks are reported per ID in a *DeleteConfigurationsError.
+func (c *Client) DeleteConfigurations(ctx context.Context, ids []string) error {
+	failed := map[string]error{}
+	for start := 0; start < len(ids); start += DefaultDeleteBatchSize {
+		end := start + DefaultDeleteBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		chunk := ids[start:end]
+
+		var deleted bool
+		resp := restResult{Data: &deleted}
+		err := c.request(
+			ctx, http.MethodDelete, c.baseURL+ConfigurationPath, &resp,
+			withAuthentication(c.accessToken),
+			withQuery(
+				"delType", "ids",
+				"ids", strings.Join(chunk, ",")))
+		if err == nil {
+			err = resp.err()
+		}
+		if err == nil && !deleted {
+			err = fmt.Errorf("server did not delete the configurations")
+		}
+		if err != nil {
+			for _, id := range chunk {
+				failed[id] = err
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		return &DeleteConfigurationsError{Failed: failed}
+	}
+	return nil
+}
+
+// ExportConfigurations downloads the configurations of a namespace as a ZIP archive.
+// Format selects between the legacy `export=true` layout and the `exportV2=true` one,
+// which also carries the type and description of every item.
+func (c *Client) ExportConfigurations(ctx context.Context, params *ExportConfigurationsParams) ([]byte, error) {
+	exportFlag := "exportV2"
+	switch params.Format {
+	case ExportFormatV1:
+		exportFlag = "export"
+	case "", ExportFormatV2:
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", params.Format)
+	}
+
+	query := []string{
+		exportFlag, "true",
+		"tenant", NormalizeNamespace(params.Namespace),
+		"group", params.Group,
+		"appName", params.AppName,
+	}
+	if len(params.Ids) > 0 {
+		query = append(query, "ids", strings.Join(params.Ids, ","))
+	}
+
+	var resp []byte
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+ConfigurationPath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery(query...))
+	if err != nil {
+		return nil, fmt.Errorf("export configurations error: %v", err)
+	}
+
+	return resp, nil
+}
+
+// ImportConfigurations uploads a ZIP archive, as produced by ExportConfigurations, into a namespace.
+// Policy decides what happens with configurations which already exist in the namespace.
+func (c *Client) ImportConfigurations(ctx context.Context, params *ImportConfigurationsParams) (*BatchResult, error) {
+	var result BatchResult
+	resp := restResult{Data: &result}
+	err := c.request(
+		ctx, http.MethodPost, c.baseURL+ConfigurationPath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery(
+			"import", "true",
+			"namespace", NormalizeNamespace(params.Namespace),
+			"policy", params.Policy),
+		withFile("file", params.FileName, params.Archive))
+	if err == nil {
+		err = resp.err()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("import configurations error: %v", err)
+	}
+
+	return &result, nil
+}
+
+// SearchConfigurations returns one page of the configurations matching params.
+// Unlike GetConfiguration, the description and tags are not part of the result.
+func (c *Client) SearchConfigurations(ctx context.Context, params *SearchConfigurationsParams) (*ConfigurationPage, error) {
+	mode := params.Mode
+	if mode == "" {
+		mode = SearchAccurate
+	}
+	pageNo, pageSize := params.PageNo, params.PageSize
+	if pageNo <= 0 {
+		pageNo = 1
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultSearchPageSize
+	}
+
+	var resp ConfigurationPage
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+ConfigurationPath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery(
+			"search", mode,
+			"tenant", NormalizeNamespace(params.Namespace),
+			"group", params.Group,
+			"dataId", params.Key,
+			"pageNo", strconv.Itoa(pageNo),
+			"pageSize", strconv.Itoa(pageSize)))
+	if err != nil {
+		return nil, fmt.Errorf("search configurations error: %v", err)
+	}
+
+	for i, item := range resp.PageItems {
+		if resp.PageItems[i].Value, err = c.decrypt(item.Key, item.Value, item.EncryptedDataKey); err != nil {
+			return nil, fmt.Errorf("search configurations error: %v", err)
+		}
+	}
+
+	return &resp, nil
+}
+
+// ListConfigurations walks all the pages of SearchConfigurations
+func (c *Client) ListConfigurations(ctx context.Context, params *SearchConfigurationsParams) ([]ConfigurationInfo, error) {
+	pageParams := *params
+	if pageParams.PageNo <= 0 {
+		pageParams.PageNo = 1
+	}
+
+	var items []ConfigurationInfo
+	for {
+		page, err := c.SearchConfigurations(ctx, &pageParams)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.PageItems...)
+		if len(page.PageItems) == 0 || pageParams.PageNo >= page.PagesAvailable {
+			return items, nil
+		}
+		pageParams.PageNo++
+	}
+}
+
+// CloneConfigurations copies the configurations identified by their Nacos IDs into another namespace
+func (c *Client) CloneConfigurations(ctx context.Context, params *CloneConfigurationsParams) (*BatchResult, error) {
+	var result BatchResult
+	resp := restResult{Data: &result}
+	err := c.request(
+		ctx, http.MethodPost, c.baseURL+ConfigurationPath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery(
+			"clone", "true",
+			"tenant", NormalizeNamespace(params.TargetNamespace),
+			"policy", params.Policy),
+		withJSON(params.Items))
+	if err == nil {
+		err = resp.err()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("clone configurations error: %v", err)
+	}
+
+	return &result, nil
+}
+
+// GetConfigurationListeners lists the clients listening to a configuration, sorted by IP
+func (c *Client) GetConfigurationListeners(ctx context.Context, params *ConfigurationId) ([]ConfigurationListener, error) {
+	var resp listenerStatus
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+ConfigurationListenerPath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery(
+			"tenant", NormalizeNamespace(params.Namespace),
+			"group", groupOrDefault(params.Group),
+			"dataId", params.Key))
+	if err == nil {
+		err = resp.err()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get configuration listeners error: %v", err)
+	}
+
+	listeners := make([]ConfigurationListener, 0, len(resp.Status))
+	for ip, md5 := range resp.Status {
+		listeners = append(listeners, ConfigurationListener{Ip: ip, MD5: md5})
+	}
+	sort.Slice(listeners, func(i, j int) bool {
+		return listeners[i].Ip < listeners[j].Ip
+	})
+	return listeners, nil
+}
+
+// GetListenedConfigurations lists the configurations a client listens to, sorted by namespace, group and key
+func (c *Client) GetListenedConfigurations(ctx context.Context, params *ListenedConfigurationsParams) ([]ListenedConfiguration, error) {
+	var resp listenerStatus
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+ListenerPath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery(
+			"ip", params.Ip,
+			"all", strconv.FormatBool(params.All),
+			"tenant", NormalizeNamespace(params.Namespace)))
+	if err == nil {
+		err = resp.err()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get listened configurations error: %v", err)
+	}
+
+	configurations := make([]ListenedConfiguration, 0, len(resp.Status))
+	for groupKey, md5 := range resp.Status {
+		key, group, namespace, err := parseGroupKey(groupKey)
+		if err != nil {
+			return nil, fmt.Errorf("get listened configurations error: %v", err)
+		}
+		configurations = append(configurations, ListenedConfiguration{
+			Namespace: namespace,
+			Group:     group,
+			Key:       key,
+			MD5:       md5,
+		})
+	}
+	sort.Slice(configurations, func(i, j int) bool {
+		a, b := configurations[i], configurations[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		return a.Key < b.Key
+	})
+	return configurations, nil
+}
+
+func (c *Client) encrypt(key, content string) (string, string, error) {
+	algorithm, ok := CipherAlgorithm(key)
+	if !ok {
+		return content, "", nil
+	}
+	encryptor, ok := c.encryptors[algorithm]
+	if !ok {
+		log.Printf("[WARN] no encryptor for algorithm=%s, publishing %s as is\n", algorithm, key)
+		return content, "", nil
+	}
+
+	encrypted, encryptedDataKey, err := encryptor.Encrypt(content)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encrypt %s: %v", key, err)
+	}
+	return encrypted, encryptedDataKey, nil
+}
+
+// decrypt leaves the content as is without data key, e.g. when the server already decrypted it
+func (c *Client) decrypt(key, content, encryptedDataKey string) (string, error) {
+	algorithm, ok := CipherAlgorithm(key)
+	if !ok || encryptedDataKey == "" {
+		return content, nil
+	}
+	encryptor, ok := c.encryptors[algorithm]
+	if !ok {
+		return content, nil
+	}
+
+	decrypted, err := encryptor.Decrypt(content, encryptedDataKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt %s: %v", key, err)
+	}
+	return decrypted, nil
+}
@@ -3,9 +3,12 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,8 +17,10 @@ import (
 const (
 	_AccessToken = "test-access-token"
 
-	_LoginPath         = "/nacos/v1/auth/login"
-	_ConfigurationPath = "/nacos/v1/cs/configs"
+	_LoginPath                 = "/nacos/v1/auth/login"
+	_ConfigurationPath         = "/nacos/v1/cs/configs"
+	_ConfigurationListenerPath = "/nacos/v1/cs/configs/listener"
+	_ListenerPath              = "/nacos/v1/cs/listener"
 )
 
 func defaultLoginHandler(w http.ResponseWriter, _ *http.Request) {
@@ -99,6 +104,7 @@ func TestClient_GetConfiguration(t *testing.T) {
 		name             string
 		getConfigHandler http.HandlerFunc
 		expectErr        error
+		expectNotFound   bool
 	}{
 		{
 			name: "request error",
@@ -114,7 +120,8 @@ func TestClient_GetConfiguration(t *testing.T) {
 				w.Header().Set("Content-Type", "application/json")
 				_, _ = w.Write(jsonResp)
 			},
-			expectErr: fmt.Errorf("not found"),
+			expectErr:      fmt.Errorf("not found"),
+			expectNotFound: true,
 		},
 		{
 			name: "success",
@@ -204,6 +211,7 @@ func TestClient_GetConfiguration(t *testing.T) {
 			})
 			assert.Nil(t, err)
 			_, err = client.GetConfiguration(context.Background(), configurationId)
+			assert.Equal(t, tt.expectNotFound, errors.Is(err, ErrNotFound))
 		})
 	}
 }
@@ -308,6 +316,8 @@ func TestClient_PublishConfiguration(t *testing.T) {
 				Key:         "key",
 				Value:       "value",
 				Description: "description",
+				Type:        ConfigurationTypeYAML,
+				Tags:        "a,b",
 			}
 			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				switch r.URL.Path {
@@ -321,6 +331,8 @@ func TestClient_PublishConfiguration(t *testing.T) {
 						assert.Equal(t, configuration.Key, r.FormValue("dataId"))
 						assert.Equal(t, configuration.Value, r.FormValue("content"))
 						assert.Equal(t, configuration.Description, r.FormValue("desc"))
+						assert.Equal(t, configuration.Type, r.FormValue("type"))
+						assert.Equal(t, configuration.Tags, r.FormValue("config_tags"))
 
 						tt.publishConfigHandler(w, r)
 					}
@@ -345,3 +357,685 @@ func TestClient_PublishConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ExportConfigurations(t *testing.T) {
+	tests := []struct {
+		name                string
+		params              *ExportConfigurationsParams
+		exportConfigHandler http.HandlerFunc
+		expectFlag          string
+		expectErr           error
+	}{
+		{
+			name:      "unsupported format",
+			params:    &ExportConfigurationsParams{Namespace: "namespace", Format: "v3"},
+			expectErr: fmt.Errorf("unsupported export format"),
+		},
+		{
+			name:   "request error",
+			params: &ExportConfigurationsParams{Namespace: "namespace"},
+			exportConfigHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expectFlag: "exportV2",
+			expectErr:  fmt.Errorf("internal server error"),
+		},
+		{
+			name:   "success v1",
+			params: &ExportConfigurationsParams{Namespace: "namespace", Group: "GROUP", Ids: []string{"1", "2"}, Format: ExportFormatV1},
+			exportConfigHandler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "1,2", r.URL.Query().Get("ids"))
+				w.Header().Set("Content-Type", "application/zip")
+				_, _ = w.Write([]byte("archive"))
+			},
+			expectFlag: "export",
+		},
+		{
+			name:   "success v2",
+			params: &ExportConfigurationsParams{Namespace: "namespace", Group: "GROUP", Format: ExportFormatV2},
+			exportConfigHandler: func(w http.ResponseWriter, r *http.Request) {
+				_, ok := r.URL.Query()["ids"]
+				assert.False(t, ok)
+				w.Header().Set("Content-Type", "application/zip")
+				_, _ = w.Write([]byte("archive"))
+			},
+			expectFlag: "exportV2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case _LoginPath:
+					defaultLoginHandler(w, r)
+
+				case _ConfigurationPath:
+					if r.Method == "GET" {
+						assert.Equal(t, "true", r.URL.Query().Get(tt.expectFlag))
+						assert.Equal(t, tt.params.Namespace, r.URL.Query().Get("tenant"))
+						assert.Equal(t, tt.params.Group, r.URL.Query().Get("group"))
+
+						tt.exportConfigHandler(w, r)
+					}
+
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(&Config{
+				Address:     server.URL,
+				ContextPath: "nacos",
+			})
+			assert.Nil(t, err)
+			archive, err := client.ExportConfigurations(context.Background(), tt.params)
+			if tt.expectErr != nil {
+				assert.NotNil(t, err)
+				assert.Nil(t, archive)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, []byte("archive"), archive)
+			}
+		})
+	}
+}
+
+func TestClient_ImportConfigurations(t *testing.T) {
+	tests := []struct {
+		name                string
+		importConfigHandler http.HandlerFunc
+		expectErr           error
+		expectRes           *BatchResult
+	}{
+		{
+			name: "request error",
+			importConfigHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expectErr: fmt.Errorf("status_code = 500"),
+		},
+		{
+			name: "failed result",
+			importConfigHandler: func(w http.ResponseWriter, r *http.Request) {
+				jsonResp, _ := json.Marshal(map[string]interface{}{
+					"code":    100005,
+					"message": "namespace does not exist",
+				})
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(jsonResp)
+			},
+			expectErr: fmt.Errorf("namespace does not exist"),
+		},
+		{
+			name: "success",
+			importConfigHandler: func(w http.ResponseWriter, r *http.Request) {
+				jsonResp, _ := json.Marshal(map[string]interface{}{
+					"code":    200,
+					"message": "success",
+					"data": map[string]interface{}{
+						"succCount": 2,
+						"skipCount": 1,
+						"skipData": []map[string]string{
+							{"group": "GROUP", "dataId": "skipped"},
+						},
+					},
+				})
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(jsonResp)
+			},
+			expectRes: &BatchResult{
+				SuccessCount: 2,
+				SkipCount:    1,
+				SkipData:     []BatchResultItem{{Group: "GROUP", Key: "skipped"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &ImportConfigurationsParams{
+				Namespace: "namespace",
+				Policy:    PolicySkip,
+				FileName:  "archive.zip",
+				Archive:   []byte("archive"),
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case _LoginPath:
+					defaultLoginHandler(w, r)
+
+				case _ConfigurationPath:
+					if r.Method == "POST" {
+						assert.Equal(t, "true", r.URL.Query().Get("import"))
+						assert.Equal(t, params.Namespace, r.URL.Query().Get("namespace"))
+						assert.Equal(t, params.Policy, r.URL.Query().Get("policy"))
+
+						file, header, err := r.FormFile("file")
+						assert.Nil(t, err)
+						assert.Equal(t, params.FileName, header.Filename)
+						content, _ := io.ReadAll(file)
+						assert.Equal(t, params.Archive, content)
+
+						tt.importConfigHandler(w, r)
+					}
+
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(&Config{
+				Address:     server.URL,
+				ContextPath: "nacos",
+			})
+			assert.Nil(t, err)
+			res, err := client.ImportConfigurations(context.Background(), params)
+			if tt.expectErr != nil {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectErr.Error())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func TestClient_ListConfigurations(t *testing.T) {
+	tests := []struct {
+		name            string
+		pages           []map[string]interface{}
+		statusCode      int
+		expectErr       bool
+		expectKeys      []string
+		expectRequested int
+	}{
+		{
+			name:            "request error",
+			statusCode:      http.StatusInternalServerError,
+			expectErr:       true,
+			expectRequested: 1,
+		},
+		{
+			name: "empty",
+			pages: []map[string]interface{}{
+				{"totalCount": 0, "pageNumber": 1, "pagesAvailable": 0, "pageItems": []interface{}{}},
+			},
+			expectRequested: 1,
+		},
+		{
+			name: "multiple pages",
+			pages: []map[string]interface{}{
+				{"totalCount": 3, "pageNumber": 1, "pagesAvailable": 2, "pageItems": []interface{}{
+					map[string]interface{}{"id": 1, "dataId": "key-1", "group": "GROUP"},
+					map[string]interface{}{"id": "2", "dataId": "key-2", "group": "GROUP"},
+				}},
+				{"totalCount": 3, "pageNumber": 2, "pagesAvailable": 2, "pageItems": []interface{}{
+					map[string]interface{}{"id": 3, "dataId": "key-3", "group": "GROUP"},
+				}},
+			},
+			expectKeys:      []string{"key-1", "key-2", "key-3"},
+			expectRequested: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case _LoginPath:
+					defaultLoginHandler(w, r)
+
+				case _ConfigurationPath:
+					requested++
+					assert.Equal(t, SearchAccurate, r.URL.Query().Get("search"))
+					assert.Equal(t, "namespace", r.URL.Query().Get("tenant"))
+					assert.Equal(t, "GROUP", r.URL.Query().Get("group"))
+					assert.Equal(t, fmt.Sprint(requested), r.URL.Query().Get("pageNo"))
+					assert.Equal(t, fmt.Sprint(DefaultSearchPageSize), r.URL.Query().Get("pageSize"))
+
+					if tt.statusCode != 0 {
+						w.WriteHeader(tt.statusCode)
+						return
+					}
+					jsonResp, _ := json.Marshal(tt.pages[requested-1])
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write(jsonResp)
+
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(&Config{
+				Address:     server.URL,
+				ContextPath: "nacos",
+			})
+			assert.Nil(t, err)
+			items, err := client.ListConfigurations(context.Background(), &SearchConfigurationsParams{
+				Namespace: "namespace",
+				Group:     "GROUP",
+			})
+			assert.Equal(t, tt.expectRequested, requested)
+			if tt.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			var keys []string
+			for _, item := range items {
+				keys = append(keys, item.Key)
+			}
+			assert.Equal(t, tt.expectKeys, keys)
+		})
+	}
+}
+
+func TestClient_CloneConfigurations(t *testing.T) {
+	tests := []struct {
+		name               string
+		cloneConfigHandler http.HandlerFunc
+		expectErr          bool
+		expectRes          *BatchResult
+	}{
+		{
+			name: "request error",
+			cloneConfigHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expectErr: true,
+		},
+		{
+			name: "success",
+			cloneConfigHandler: func(w http.ResponseWriter, r *http.Request) {
+				jsonResp, _ := json.Marshal(map[string]interface{}{
+					"code": 200,
+					"data": map[string]interface{}{
+						"succCount": 1,
+						"skipCount": 0,
+					},
+				})
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(jsonResp)
+			},
+			expectRes: &BatchResult{SuccessCount: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := &CloneConfigurationsParams{
+				TargetNamespace: "target",
+				Policy:          PolicyOverwrite,
+				Items:           []CloneItem{{Id: "1", Group: "GROUP", Key: "key"}},
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case _LoginPath:
+					defaultLoginHandler(w, r)
+
+				case _ConfigurationPath:
+					if r.Method == "POST" {
+						assert.Equal(t, "true", r.URL.Query().Get("clone"))
+						assert.Equal(t, params.TargetNamespace, r.URL.Query().Get("tenant"))
+						assert.Equal(t, params.Policy, r.URL.Query().Get("policy"))
+						assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+						body, _ := io.ReadAll(r.Body)
+						assert.JSONEq(t, `[{"cfgId":"1","group":"GROUP","dataId":"key"}]`, string(body))
+
+						tt.cloneConfigHandler(w, r)
+					}
+
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(&Config{
+				Address:     server.URL,
+				ContextPath: "nacos",
+			})
+			assert.Nil(t, err)
+			res, err := client.CloneConfigurations(context.Background(), params)
+			if tt.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func TestClient_DeleteConfigurations(t *testing.T) {
+	ids := make([]string, 0, DefaultDeleteBatchSize+2)
+	for i := 0; i < DefaultDeleteBatchSize+2; i++ {
+		ids = append(ids, fmt.Sprint(i))
+	}
+
+	tests := []struct {
+		name                 string
+		deleteConfigsHandler func(chunk int) http.HandlerFunc
+		expectFailed         []string
+	}{
+		{
+			name: "success",
+			deleteConfigsHandler: func(int) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{"code":200,"data":true}`))
+				}
+			},
+		},
+		{
+			name: "second chunk failed",
+			deleteConfigsHandler: func(chunk int) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					if chunk == 2 {
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{"code":200,"data":true}`))
+				}
+			},
+			expectFailed: ids[DefaultDeleteBatchSize:],
+		},
+		{
+			name: "not deleted",
+			deleteConfigsHandler: func(int) http.HandlerFunc {
+				return func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{"code":200,"data":false}`))
+				}
+			},
+			expectFailed: ids,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunk := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case _LoginPath:
+					defaultLoginHandler(w, r)
+
+				case _ConfigurationPath:
+					if r.Method == "DELETE" {
+						chunk++
+						expectIds := ids[:DefaultDeleteBatchSize]
+						if chunk == 2 {
+							expectIds = ids[DefaultDeleteBatchSize:]
+						}
+						assert.Equal(t, "ids", r.URL.Query().Get("delType"))
+						assert.Equal(t, strings.Join(expectIds, ","), r.URL.Query().Get("ids"))
+
+						tt.deleteConfigsHandler(chunk)(w, r)
+					}
+
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(&Config{
+				Address:     server.URL,
+				ContextPath: "nacos",
+			})
+			assert.Nil(t, err)
+			err = client.DeleteConfigurations(context.Background(), ids)
+			assert.Equal(t, 2, chunk)
+			if len(tt.expectFailed) == 0 {
+				assert.Nil(t, err)
+				return
+			}
+
+			deleteErr, ok := err.(*DeleteConfigurationsError)
+			assert.True(t, ok)
+			var failed []string
+			for _, id := range ids {
+				if _, ok := deleteErr.Failed[id]; ok {
+					failed = append(failed, id)
+				}
+			}
+			assert.Equal(t, tt.expectFailed, failed)
+		})
+	}
+}
+
+func TestClient_EncryptedConfiguration(t *testing.T) {
+	encryptor, err := NewAESEncryptor("0123456789abcdef")
+	assert.Nil(t, err)
+
+	stored := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case _LoginPath:
+			defaultLoginHandler(w, r)
+
+		case _ConfigurationPath:
+			switch r.Method {
+			case "POST":
+				key := r.FormValue("dataId")
+				stored[key] = r.FormValue("content")
+				stored[key+".encryptedDataKey"] = r.FormValue("encryptedDataKey")
+				_, _ = w.Write([]byte("true"))
+			case "GET":
+				key := r.URL.Query().Get("dataId")
+				jsonResp, _ := json.Marshal(map[string]interface{}{
+					"dataId":           key,
+					"content":          stored[key],
+					"encryptedDataKey": stored[key+".encryptedDataKey"],
+				})
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(jsonResp)
+			}
+
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		Address:     server.URL,
+		ContextPath: "nacos",
+		Encryptors:  []Encryptor{encryptor},
+	})
+	assert.Nil(t, err)
+
+	for _, tc := range []struct {
+		key       string
+		encrypted bool
+	}{
+		{key: "cipher-aes-secret", encrypted: true},
+		{key: "cipher-sm4-secret", encrypted: false},
+		{key: "plain", encrypted: false},
+	} {
+		t.Run(tc.key, func(t *testing.T) {
+			err := client.PublishConfiguration(context.Background(), &Configuration{Key: tc.key, Value: "value"})
+			assert.Nil(t, err)
+			assert.Equal(t, tc.encrypted, stored[tc.key] != "value")
+			assert.Equal(t, tc.encrypted, stored[tc.key+".encryptedDataKey"] != "")
+
+			configuration, err := client.GetConfiguration(context.Background(), &ConfigurationId{Key: tc.key})
+			assert.Nil(t, err)
+			assert.Equal(t, "value", configuration.Value)
+		})
+	}
+}
+
+func TestClient_PublicNamespaceAndDefaultGroup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case _LoginPath:
+			defaultLoginHandler(w, r)
+
+		case _ConfigurationPath:
+			_ = r.ParseForm()
+			assert.Equal(t, "", r.Form.Get("tenant"))
+			assert.Equal(t, DefaultGroup, r.Form.Get("group"))
+			w.Header().Set("Content-Type", "application/json")
+			if r.Method == http.MethodPost {
+				_, _ = w.Write([]byte("true"))
+				return
+			}
+			jsonResp, _ := json.Marshal(map[string]interface{}{
+				"tenant":  "",
+				"group":   DefaultGroup,
+				"dataId":  "key",
+				"content": "value",
+			})
+			_, _ = w.Write(jsonResp)
+
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		Address:     server.URL,
+		ContextPath: "nacos",
+	})
+	assert.Nil(t, err)
+
+	for _, namespace := range []string{"", PublicNamespace} {
+		_, err = client.GetConfiguration(context.Background(), &ConfigurationId{Namespace: namespace, Key: "key"})
+		assert.Nil(t, err)
+		err = client.PublishConfiguration(context.Background(), &Configuration{Namespace: namespace, Key: "key", Value: "value"})
+		assert.Nil(t, err)
+	}
+}
+
+func TestClient_GetConfigurationListeners(t *testing.T) {
+	tests := []struct {
+		name            string
+		listenerHandler http.HandlerFunc
+		expectErr       bool
+		expectRes       []ConfigurationListener
+	}{
+		{
+			name: "request error",
+			listenerHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expectErr: true,
+		},
+		{
+			name: "collect error",
+			listenerHandler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"collectStatus":500}`))
+			},
+			expectErr: true,
+		},
+		{
+			name: "success",
+			listenerHandler: func(w http.ResponseWriter, r *http.Request) {
+				jsonResp, _ := json.Marshal(map[string]interface{}{
+					"collectStatus": 200,
+					"lisentersGroupkeyStatus": map[string]string{
+						"10.0.0.2": "md5-2",
+						"10.0.0.1": "md5-1",
+					},
+				})
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(jsonResp)
+			},
+			expectRes: []ConfigurationListener{
+				{Ip: "10.0.0.1", MD5: "md5-1"},
+				{Ip: "10.0.0.2", MD5: "md5-2"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case _LoginPath:
+					defaultLoginHandler(w, r)
+
+				case _ConfigurationListenerPath:
+					assert.Equal(t, "namespace", r.URL.Query().Get("tenant"))
+					assert.Equal(t, "GROUP", r.URL.Query().Get("group"))
+					assert.Equal(t, "key", r.URL.Query().Get("dataId"))
+					tt.listenerHandler(w, r)
+
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			defer server.Close()
+
+			client, err := NewClient(&Config{
+				Address:     server.URL,
+				ContextPath: "nacos",
+			})
+			assert.Nil(t, err)
+			res, err := client.GetConfigurationListeners(context.Background(), &ConfigurationId{
+				Namespace: "namespace",
+				Group:     "GROUP",
+				Key:       "key",
+			})
+			if tt.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func TestClient_GetListenedConfigurations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case _LoginPath:
+			defaultLoginHandler(w, r)
+
+		case _ListenerPath:
+			assert.Equal(t, "10.0.0.1", r.URL.Query().Get("ip"))
+			assert.Equal(t, "true", r.URL.Query().Get("all"))
+			jsonResp, _ := json.Marshal(map[string]interface{}{
+				"collectStatus": 200,
+				"lisentersGroupkeyStatus": map[string]string{
+					"b.yaml+GROUP+sandbox":   "md5-b",
+					"a.yaml+GROUP+sandbox":   "md5-a",
+					"app.yaml+DEFAULT_GROUP": "md5-public",
+				},
+			})
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(jsonResp)
+
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		Address:     server.URL,
+		ContextPath: "nacos",
+	})
+	assert.Nil(t, err)
+	res, err := client.GetListenedConfigurations(context.Background(), &ListenedConfigurationsParams{
+		Ip:  "10.0.0.1",
+		All: true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []ListenedConfiguration{
+		{Namespace: "", Group: "DEFAULT_GROUP", Key: "app.yaml", MD5: "md5-public"},
+		{Namespace: "sandbox", Group: "GROUP", Key: "a.yaml", MD5: "md5-a"},
+		{Namespace: "sandbox", Group: "GROUP", Key: "b.yaml", MD5: "md5-b"},
+	}, res)
+}
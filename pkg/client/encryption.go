@@ -0,0 +1,152 @@
+package client
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	CipherPrefix = "cipher-"
+	AESAlgorithm = "aes"
+
+	dataKeyLength = 16
+	dataKeyChars  = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// Encryptor encrypts the content of configurations whose key starts with `cipher-<Algorithm>-`,
+// following the convention of the Nacos config encryption plugin:
+// the content is encrypted with a data key, which is stored encrypted next to it as `encryptedDataKey`.
+type Encryptor interface {
+	Algorithm() string
+	Encrypt(content string) (encrypted, encryptedDataKey string, err error)
+	Decrypt(encrypted, encryptedDataKey string) (string, error)
+}
+
+// CipherAlgorithm returns the algorithm of a cipher-prefixed configuration key
+func CipherAlgorithm(key string) (string, bool) {
+	if !strings.HasPrefix(key, CipherPrefix) {
+		return "", false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(key, CipherPrefix), "-", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+	return parts[0], true
+}
+
+type aesEncryptor struct {
+	secretKey []byte
+}
+
+// NewAESEncryptor creates the `aes` Encryptor, secretKey encrypts the data keys and must be 16, 24 or 32 bytes long.
+// Content and data keys are encrypted with AES/ECB/PKCS5Padding and base64 encoded, like the Nacos AES plugin.
+func NewAESEncryptor(secretKey string) (Encryptor, error) {
+	if _, err := aes.NewCipher([]byte(secretKey)); err != nil {
+		return nil, fmt.Errorf("invalid aes secret key: %v", err)
+	}
+
+	return &aesEncryptor{secretKey: []byte(secretKey)}, nil
+}
+
+func (e *aesEncryptor) Algorithm() string {
+	return AESAlgorithm
+}
+
+func (e *aesEncryptor) Encrypt(content string) (string, string, error) {
+	dataKey, err := generateDataKey()
+	if err != nil {
+		return "", "", err
+	}
+
+	encrypted, err := aesECBEncrypt([]byte(dataKey), []byte(content))
+	if err != nil {
+		return "", "", err
+	}
+	encryptedDataKey, err := aesECBEncrypt(e.secretKey, []byte(dataKey))
+	if err != nil {
+		return "", "", err
+	}
+
+	return encrypted, encryptedDataKey, nil
+}
+
+func (e *aesEncryptor) Decrypt(encrypted, encryptedDataKey string) (string, error) {
+	dataKey, err := aesECBDecrypt(e.secretKey, encryptedDataKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt data key: %v", err)
+	}
+
+	content, err := aesECBDecrypt(dataKey, encrypted)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt content: %v", err)
+	}
+	return string(content), nil
+}
+
+// generateDataKey picks every character uniformly, rand.Int rejects the values which would bias a modulo
+func generateDataKey() (string, error) {
+	max := big.NewInt(int64(len(dataKeyChars)))
+	b := make([]byte, dataKeyLength)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate data key: %v", err)
+		}
+		b[i] = dataKeyChars[n.Int64()]
+	}
+	return string(b), nil
+}
+
+func aesECBEncrypt(key, plaintext []byte) (string, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	size := block.BlockSize()
+	padding := size - len(plaintext)%size
+	src := append(append([]byte{}, plaintext...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	dst := make([]byte, len(src))
+	ecbCrypt(block.Encrypt, block, dst, src)
+
+	return base64.StdEncoding.EncodeToString(dst), nil
+}
+
+func aesECBDecrypt(key []byte, encoded string) ([]byte, error) {
+	src, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	size := block.BlockSize()
+	if len(src) == 0 || len(src)%size != 0 {
+		return nil, fmt.Errorf("ciphertext is not a multiple of the block size")
+	}
+	dst := make([]byte, len(src))
+	ecbCrypt(block.Decrypt, block, dst, src)
+
+	padding := int(dst[len(dst)-1])
+	if padding == 0 || padding > size || !bytes.Equal(dst[len(dst)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return nil, fmt.Errorf("invalid padding")
+	}
+	return dst[:len(dst)-padding], nil
+}
+
+// ecbCrypt applies fn block by block, the standard library does not provide the ECB mode
+func ecbCrypt(fn func(dst, src []byte), block cipher.Block, dst, src []byte) {
+	size := block.BlockSize()
+	for i := 0; i < len(src); i += size {
+		fn(dst[i:i+size], src[i:i+size])
+	}
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCipherAlgorithm(t *testing.T) {
+	testcases := []struct {
+		key       string
+		algorithm string
+		ok        bool
+	}{
+		{key: "cipher-aes-application.yaml", algorithm: "aes", ok: true},
+		{key: "cipher-sm4-key", algorithm: "sm4", ok: true},
+		{key: "cipher-aes-", ok: false},
+		{key: "cipher-aes", ok: false},
+		{key: "application.yaml", ok: false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.key, func(t *testing.T) {
+			algorithm, ok := CipherAlgorithm(tc.key)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.algorithm, algorithm)
+		})
+	}
+}
+
+func TestNewAESEncryptor(t *testing.T) {
+	_, err := NewAESEncryptor("too short")
+	assert.NotNil(t, err)
+
+	for _, key := range []string{"0123456789abcdef", "0123456789abcdef01234567", "0123456789abcdef0123456789abcdef"} {
+		encryptor, err := NewAESEncryptor(key)
+		assert.Nil(t, err)
+		assert.Equal(t, AESAlgorithm, encryptor.Algorithm())
+	}
+}
+
+func TestAESEncryptor(t *testing.T) {
+	encryptor, _ := NewAESEncryptor("0123456789abcdef")
+	other, _ := NewAESEncryptor("fedcba9876543210")
+
+	for _, content := range []string{"", "secret", "0123456789abcdef", "password: \"multi\nline\""} {
+		encrypted, encryptedDataKey, err := encryptor.Encrypt(content)
+		assert.Nil(t, err)
+		assert.NotEqual(t, content, encrypted)
+		assert.NotEmpty(t, encryptedDataKey)
+
+		decrypted, err := encryptor.Decrypt(encrypted, encryptedDataKey)
+		assert.Nil(t, err)
+		assert.Equal(t, content, decrypted)
+
+		decrypted, err = other.Decrypt(encrypted, encryptedDataKey)
+		assert.True(t, err != nil || decrypted != content)
+	}
+
+	_, err := encryptor.Decrypt("not base64!", "not base64!")
+	assert.NotNil(t, err)
+}
@@ -1,10 +1,12 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"regexp"
@@ -15,12 +17,21 @@ import (
 type requestOption struct {
 	form  *url.Values
 	query *url.Values
+	file  *multipartFile
+	json  []byte
+}
+
+type multipartFile struct {
+	field    string
+	fileName string
+	content  []byte
 }
 
 type requestOptionFn func(*requestOption) error
 
 const (
 	defaultPOSTContentType = "application/x-www-form-urlencoded"
+	jsonContentType        = "application/json"
 	accessTokenQueryName   = "accessToken"
 )
 
@@ -65,6 +76,58 @@ func withForm(kv ...string) requestOptionFn {
 	}
 }
 
+// withFile sends the request as multipart/form-data, form values are sent as regular fields
+func withFile(field, fileName string, content []byte) requestOptionFn {
+	return func(rOpts *requestOption) error {
+		rOpts.file = &multipartFile{
+			field:    field,
+			fileName: fileName,
+			content:  content,
+		}
+		return nil
+	}
+}
+
+// withJSON sends v as the JSON encoded request body
+func withJSON(v interface{}) requestOptionFn {
+	return func(rOpts *requestOption) error {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("json body: %v", err)
+		}
+
+		rOpts.json = b
+		return nil
+	}
+}
+
+func newMultipartBody(form *url.Values, file *multipartFile) (io.Reader, string, error) {
+	body := new(bytes.Buffer)
+	w := multipart.NewWriter(body)
+	if form != nil {
+		for k, vs := range *form {
+			for _, v := range vs {
+				if err := w.WriteField(k, v); err != nil {
+					return nil, "", err
+				}
+			}
+		}
+	}
+
+	part, err := w.CreateFormFile(file.field, file.fileName)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err = part.Write(file.content); err != nil {
+		return nil, "", err
+	}
+	if err = w.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return body, w.FormDataContentType(), nil
+}
+
 func newRequest(ctx context.Context, method, url string, opts ...requestOptionFn) (*http.Request, error) {
 	var (
 		err  error
@@ -80,16 +143,27 @@ func newRequest(ctx context.Context, method, url string, opts ...requestOptionFn
 		}
 	}
 
-	if rOpt.form != nil {
+	contentType := ""
+	switch {
+	case rOpt.file != nil:
+		body, contentType, err = newMultipartBody(rOpt.form, rOpt.file)
+		if err != nil {
+			return nil, err
+		}
+	case rOpt.json != nil:
+		body = bytes.NewReader(rOpt.json)
+		contentType = jsonContentType
+	case rOpt.form != nil:
 		body = strings.NewReader(rOpt.form.Encode())
+		contentType = defaultPOSTContentType
 	}
 	req, err = http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	if rOpt.form != nil {
-		req.Header.Set("Content-Type", defaultPOSTContentType)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
 	}
 
 	if rOpt.query != nil {
@@ -131,6 +205,10 @@ func sendRequest(req *http.Request, result interface{}) error {
 			resp.StatusCode, string(body))
 	}
 
+	if raw, ok := result.(*[]byte); ok {
+		*raw = body
+		return nil
+	}
 	if len(body) == 0 {
 		return nil
 	}
@@ -157,3 +235,21 @@ func (ts *cString) set(s string) {
 	defer ts.mux.Unlock()
 	ts.v = s
 }
+
+// parseGroupKey splits the `dataId+group[+tenant]` keys of the listener endpoints,
+// `+` and `%` are escaped as `%2B` and `%25` inside of each part
+func parseGroupKey(groupKey string) (key, group, namespace string, err error) {
+	parts := strings.Split(groupKey, "+")
+	if len(parts) != 2 && len(parts) != 3 {
+		return "", "", "", fmt.Errorf("invalid group key: %s", groupKey)
+	}
+
+	unescaper := strings.NewReplacer("%2B", "+", "%25", "%")
+	for i, part := range parts {
+		parts[i] = unescaper.Replace(part)
+	}
+	if len(parts) == 3 {
+		namespace = parts[2]
+	}
+	return parts[0], parts[1], namespace, nil
+}
@@ -38,3 +38,33 @@ func TestNewRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestParseGroupKey(t *testing.T) {
+	testcases := []struct {
+		name      string
+		groupKey  string
+		key       string
+		group     string
+		namespace string
+		expectErr bool
+	}{
+		{name: "public namespace", groupKey: "app.yaml+DEFAULT_GROUP", key: "app.yaml", group: "DEFAULT_GROUP"},
+		{name: "namespace", groupKey: "app.yaml+GROUP+sandbox", key: "app.yaml", group: "GROUP", namespace: "sandbox"},
+		{name: "escaped", groupKey: "a%2Bb%25+GROUP+sandbox", key: "a+b%", group: "GROUP", namespace: "sandbox"},
+		{name: "invalid", groupKey: "app.yaml", expectErr: true},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, group, namespace, err := parseGroupKey(tc.groupKey)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.key, key)
+			assert.Equal(t, tc.group, group)
+			assert.Equal(t, tc.namespace, namespace)
+		})
+	}
+}
@@ -1,11 +1,23 @@
 package client
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
 type Configuration struct {
 	Namespace   string `json:"tenant"`
 	Group       string `json:"group"`
 	Key         string `json:"dataId"`
 	Value       string `json:"content"`
 	Description string `json:"desc"`
+	Type        string `json:"type"`
+	Tags        string `json:"configTags"`
+	MD5         string `json:"md5"`
+
+	EncryptedDataKey string `json:"encryptedDataKey"`
 }
 
 type ConfigurationId struct {
@@ -14,6 +26,374 @@ type ConfigurationId struct {
 	Key       string
 }
 
+// ConfigurationInfo is a configuration as listed by SearchConfigurations
+type ConfigurationInfo struct {
+	Id        json.Number `json:"id"`
+	Namespace string      `json:"tenant"`
+	Group     string      `json:"group"`
+	Key       string      `json:"dataId"`
+	Value     string      `json:"content"`
+	MD5       string      `json:"md5"`
+	Type      string      `json:"type"`
+	AppName   string      `json:"appName"`
+
+	EncryptedDataKey string `json:"encryptedDataKey"`
+}
+
+type ConfigurationPage struct {
+	TotalCount     int                 `json:"totalCount"`
+	PageNumber     int                 `json:"pageNumber"`
+	PagesAvailable int                 `json:"pagesAvailable"`
+	PageItems      []ConfigurationInfo `json:"pageItems"`
+}
+
+type SearchConfigurationsParams struct {
+	Namespace string
+	Group     string
+	Key       string
+	Mode      string
+	PageNo    int
+	PageSize  int
+}
+
+type CloneConfigurationsParams struct {
+	TargetNamespace string
+	Policy          string
+	Items           []CloneItem
+}
+
+// CloneItem is a configuration to clone, Group and Key are the ones of the copy
+type CloneItem struct {
+	Id    string `json:"cfgId"`
+	Group string `json:"group"`
+	Key   string `json:"dataId"`
+}
+
+// DeleteConfigurationsError reports the IDs which DeleteConfigurations failed to delete
+type DeleteConfigurationsError struct {
+	Failed map[string]error
+}
+
+func (e *DeleteConfigurationsError) Error() string {
+	ids := make([]string, 0, len(e.Failed))
+	for id := range e.Failed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return fmt.Sprintf("delete configurations error: failed ids = %v", ids)
+}
+
+type ExportConfigurationsParams struct {
+	Namespace string
+	Group     string
+	AppName   string
+	Ids       []string
+	Format    string
+}
+
+type ArchiveItem struct {
+	Group       string `yaml:"group"`
+	Key         string `yaml:"dataId"`
+	Type        string `yaml:"type"`
+	Description string `yaml:"desc"`
+	AppName     string `yaml:"appName"`
+	Size        int    `yaml:"-"`
+}
+
+type ImportConfigurationsParams struct {
+	Namespace string
+	Policy    string
+	FileName  string
+	Archive   []byte
+}
+
+type BatchResult struct {
+	SuccessCount int               `json:"succCount"`
+	SkipCount    int               `json:"skipCount"`
+	FailData     []BatchResultItem `json:"failData"`
+	SkipData     []BatchResultItem `json:"skipData"`
+}
+
+type BatchResultItem struct {
+	Group string `json:"group"`
+	Key   string `json:"dataId"`
+}
+
+// ConfigurationListener is a client listening to a configuration, MD5 is the one of the content it last received
+type ConfigurationListener struct {
+	Ip  string
+	MD5 string
+}
+
+type ListenedConfigurationsParams struct {
+	Ip        string
+	Namespace string
+	// All lists the configurations of every namespace
+	All bool
+}
+
+// ListenedConfiguration is a configuration listened by a client, MD5 is the one of the content it last received
+type ListenedConfiguration struct {
+	Namespace string
+	Group     string
+	Key       string
+	MD5       string
+}
+
+// listenerStatus maps listener IPs, or the group keys listened by an IP, to their MD5
+type listenerStatus struct {
+	CollectStatus int               `json:"collectStatus"`
+	Status        map[string]string `json:"lisentersGroupkeyStatus"`
+}
+
+func (s *listenerStatus) err() error {
+	if s.CollectStatus != 0 && s.CollectStatus != http.StatusOK {
+		return fmt.Errorf("collect listeners error status = %v", s.CollectStatus)
+	}
+	return nil
+}
+
+type ServiceId struct {
+	Namespace string
+	Group     string
+	Name      string
+}
+
+type Service struct {
+	Namespace        string            `json:"namespaceId"`
+	Group            string            `json:"groupName"`
+	Name             string            `json:"name"`
+	ProtectThreshold float64           `json:"protectThreshold"`
+	Metadata         map[string]string `json:"metadata"`
+	Selector         *Selector         `json:"selector"`
+	Clusters         []Cluster         `json:"clusters"`
+}
+
+// Selector filters the instances returned to consumers, Expression is only used by the `label` type
+type Selector struct {
+	Type       string `json:"type"`
+	Expression string `json:"expression,omitempty"`
+}
+
+type ClusterId struct {
+	Namespace string
+	Group     string
+	Service   string
+	Name      string
+}
+
+// Cluster is a cluster of a service, Namespace, Group and Service are filled from the request.
+// CheckPort and UseIPPort4Check are not returned by every version of Nacos.
+type Cluster struct {
+	Namespace       string            `json:"-"`
+	Group           string            `json:"-"`
+	Service         string            `json:"-"`
+	Name            string            `json:"name"`
+	HealthChecker   *HealthChecker    `json:"healthChecker"`
+	CheckPort       *int              `json:"defaultCheckPort,omitempty"`
+	UseIPPort4Check *bool             `json:"useIPPort4Check,omitempty"`
+	Metadata        map[string]string `json:"metadata"`
+}
+
+// HealthChecker checks the health of persistent instances, the fields depend on Type
+type HealthChecker struct {
+	Type string `json:"type"`
+
+	// HTTP
+	Path                 string `json:"path,omitempty"`
+	Headers              string `json:"headers,omitempty"`
+	ExpectedResponseCode int    `json:"expectedResponseCode,omitempty"`
+
+	// MYSQL
+	User     string `json:"user,omitempty"`
+	Password string `json:"pwd,omitempty"`
+	Cmd      string `json:"cmd,omitempty"`
+}
+
+// InstancesMetadataParams selects the instances of a service, all the instances of ConsistencyType without Instances
+type InstancesMetadataParams struct {
+	Namespace       string
+	Group           string
+	Service         string
+	ConsistencyType string
+	Instances       []InstancesMetadataTarget
+	Metadata        map[string]string
+}
+
+type InstancesMetadataTarget struct {
+	Ip        string `json:"ip"`
+	Port      int    `json:"port"`
+	Cluster   string `json:"clusterName"`
+	Ephemeral bool   `json:"ephemeral"`
+}
+
+// InstancesMetadataResult lists the updated instances as `ip:port:consistencyType:cluster`
+type InstancesMetadataResult struct {
+	Updated []string `json:"updated"`
+}
+
+type SearchServicesParams struct {
+	Namespace string
+	Group     string
+	PageNo    int
+	PageSize  int
+}
+
+type ServicePage struct {
+	Count    int      `json:"count"`
+	Services []string `json:"doms"`
+}
+
+type InstanceId struct {
+	Namespace string
+	Group     string
+	Service   string
+	Cluster   string
+	Ip        string
+	Port      int
+	Ephemeral bool
+}
+
+// Instance is an instance of a service, Namespace, Group and Service are filled from the request
+type Instance struct {
+	Namespace  string            `json:"-"`
+	Group      string            `json:"-"`
+	Service    string            `json:"-"`
+	InstanceId string            `json:"instanceId"`
+	Cluster    string            `json:"clusterName"`
+	Ip         string            `json:"ip"`
+	Port       int               `json:"port"`
+	Weight     float64           `json:"weight"`
+	Enabled    bool              `json:"enabled"`
+	Healthy    bool              `json:"healthy"`
+	Ephemeral  bool              `json:"ephemeral"`
+	Metadata   map[string]string `json:"metadata"`
+}
+
+type ListInstancesParams struct {
+	Namespace   string
+	Group       string
+	Service     string
+	Clusters    []string
+	HealthyOnly bool
+}
+
+type instancePage struct {
+	Count int        `json:"count"`
+	List  []Instance `json:"list"`
+}
+
+type instanceList struct {
+	Name  string     `json:"name"`
+	Hosts []Instance `json:"hosts"`
+}
+
+// Switches are the cluster-wide naming switches, see SwitchDomain
+type Switches struct {
+	Name                                     string  `json:"name"`
+	DistroThreshold                          float64 `json:"distroThreshold"`
+	DistroEnabled                            bool    `json:"distroEnabled"`
+	DistroServerExpiredMillis                int64   `json:"distroServerExpiredMillis"`
+	ClientBeatInterval                       int64   `json:"clientBeatInterval"`
+	HealthCheckEnabled                       bool    `json:"healthCheckEnabled"`
+	AutoChangeHealthCheckEnabled             bool    `json:"autoChangeHealthCheckEnabled"`
+	CheckTimes                               int     `json:"checkTimes"`
+	LightBeatEnabled                         bool    `json:"lightBeatEnabled"`
+	SendBeatOnly                             bool    `json:"sendBeatOnly"`
+	DisableAddIP                             bool    `json:"disableAddIP"`
+	EnableStandalone                         bool    `json:"enableStandalone"`
+	DefaultInstanceEphemeral                 bool    `json:"defaultInstanceEphemeral"`
+	PushEnabled                              bool    `json:"pushEnabled"`
+	DefaultPushCacheMillis                   int64   `json:"defaultPushCacheMillis"`
+	DefaultCacheMillis                       int64   `json:"defaultCacheMillis"`
+	ServiceStatusSynchronizationPeriodMillis int64   `json:"serviceStatusSynchronizationPeriodMillis"`
+	ServerStatusSynchronizationPeriodMillis  int64   `json:"serverStatusSynchronizationPeriodMillis"`
+	PushJavaVersion                          string  `json:"pushJavaVersion"`
+	PushGoVersion                            string  `json:"pushGoVersion"`
+	PushPythonVersion                        string  `json:"pushPythonVersion"`
+	PushCVersion                             string  `json:"pushCVersion"`
+	PushCSharpVersion                        string  `json:"pushCSharpVersion"`
+}
+
+// NamingMetrics are the metrics of the naming module on a server,
+// the responsible counts are the services and instances the server is responsible for in the distro protocol
+type NamingMetrics struct {
+	Status                   string `json:"status"`
+	ServiceCount             int    `json:"serviceCount"`
+	InstanceCount            int    `json:"instanceCount"`
+	SubscribeCount           int    `json:"subscribeCount"`
+	ResponsibleServiceCount  int    `json:"responsibleServiceCount"`
+	ResponsibleInstanceCount int    `json:"responsibleInstanceCount"`
+	ClientCount              int    `json:"clientCount"`
+	ResponsibleClientCount   int    `json:"responsibleClientCount"`
+}
+
+// ClusterMember is a member of the Nacos cluster, see Member
+type ClusterMember struct {
+	Ip              string           `json:"ip"`
+	Port            int              `json:"port"`
+	Address         string           `json:"address"`
+	State           string           `json:"state"`
+	FailAccessCount int              `json:"failAccessCnt"`
+	ExtendInfo      MemberExtendInfo `json:"extendInfo"`
+}
+
+type MemberExtendInfo struct {
+	Version         string       `json:"version"`
+	LastRefreshTime int64        `json:"lastRefreshTime"`
+	RaftMetadata    RaftMetadata `json:"raftMetaData"`
+}
+
+// RaftMetadata describes the raft groups of a member, by group name
+type RaftMetadata struct {
+	Groups map[string]RaftGroup `json:"metaDataMap"`
+}
+
+type RaftGroup struct {
+	Leader  string   `json:"leader"`
+	Members []string `json:"raftGroupMember"`
+	Term    int64    `json:"term"`
+}
+
+// RaftPeer is a peer of the raft group of the naming module
+type RaftPeer struct {
+	Ip      string `json:"ip"`
+	VoteFor string `json:"voteFor"`
+	Term    int64  `json:"term"`
+	State   string `json:"state"`
+}
+
+type raftLeader struct {
+	Leader string `json:"leader"`
+}
+
+type Readiness struct {
+	Ready   bool
+	Message string
+}
+
+type ServerState struct {
+	Version        string
+	StandaloneMode string
+	FunctionMode   string
+	Entries        map[string]string
+}
+
+// restResult is the envelope of Nacos responses which report failures in the body
+type restResult struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data"`
+}
+
+func (r *restResult) err() error {
+	if r.Code != 0 && r.Code != 200 {
+		return fmt.Errorf("request error code = %v, message = %v", r.Code, r.Message)
+	}
+	return nil
+}
+
 type loginParams struct {
 	Username string
 	Password string
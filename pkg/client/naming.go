@@ -0,0 +1,660 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	ServicePath      = "ns/service"
+	ServiceListPath  = "ns/service/list"
+	InstancePath     = "ns/instance"
+	InstanceListPath = "ns/instance/list"
+	// CatalogInstancesPath lists all the instances of a cluster, including the disabled ones
+	CatalogInstancesPath = "ns/catalog/instances"
+	ClusterPath          = "ns/cluster"
+	MetadataPath         = "ns/instance/metadata/batch"
+	HealthPath           = "ns/health/instance"
+	SwitchesPath         = "ns/operator/switches"
+	MetricsPath          = "ns/operator/metrics"
+
+	// DefaultCluster is the cluster of instances registered without cluster
+	DefaultCluster = "DEFAULT"
+
+	SelectorTypeNone  = "none"
+	SelectorTypeLabel = "label"
+
+	HealthCheckerTCP   = "TCP"
+	HealthCheckerHTTP  = "HTTP"
+	HealthCheckerMySQL = "MYSQL"
+	HealthCheckerNone  = "NONE"
+
+	DefaultCheckPort = 80
+
+	ConsistencyTypeEphemeral = "ephemeral"
+	ConsistencyTypePersist   = "persist"
+)
+
+// entries of the naming switches accepted by SwitchManager.update, see SwitchEntry
+const (
+	SwitchEntryDistroThreshold                          = "distroThreshold"
+	SwitchEntryDistroEnabled                            = "distro"
+	SwitchEntryDistroServerExpiredMillis                = "distroServerExpiredMillis"
+	SwitchEntryClientBeatInterval                       = "clientBeatInterval"
+	SwitchEntryHealthCheckEnabled                       = "check"
+	SwitchEntryAutoChangeHealthCheckEnabled             = "autoChangeHealthCheckEnabled"
+	SwitchEntryHealthCheckTimes                         = "healthCheckTimes"
+	SwitchEntryLightBeatEnabled                         = "lightBeatEnabled"
+	SwitchEntrySendBeatOnly                             = "sendBeatOnly"
+	SwitchEntryDisableAddIP                             = "disableAddIP"
+	SwitchEntryEnableStandalone                         = "enableStandalone"
+	SwitchEntryDefaultInstanceEphemeral                 = "defaultInstanceEphemeral"
+	SwitchEntryPushEnabled                              = "pushEnabled"
+	SwitchEntryPushCacheMillis                          = "pushCacheMillis"
+	SwitchEntryDefaultCacheMillis                       = "defaultCacheMillis"
+	SwitchEntryServiceStatusSynchronizationPeriodMillis = "serviceStatusSynchronizationPeriodMillis"
+	SwitchEntryServerStatusSynchronizationPeriodMillis  = "serverStatusSynchronizationPeriodMillis"
+	// SwitchEntryPushVersion sets the minimum version of one client type, its value is `<client>:<version>`
+	SwitchEntryPushVersion = "pushVersion"
+
+	PushClientJava   = "java"
+	PushClientGo     = "go"
+	PushClientPython = "python"
+	PushClientC      = "c"
+	PushClientCSharp = "csharp"
+)
+
+// PushVersionValue is the value of the pushVersion entry which sets the minimum version of a client type
+func PushVersionValue(client, version string) string {
+	return client + ":" + version
+}
+
+// namingNamespace spells the public namespace `public`, the naming module does not accept it empty
+func namingNamespace(namespace string) string {
+	if namespace == "" {
+		return PublicNamespace
+	}
+	return namespace
+}
+
+var notFoundErrorRegexp = regexp.MustCompile(`request error status_code = (400|404|500), body = .*not found`)
+
+func isNotFoundError(err error) bool {
+	return err != nil && notFoundErrorRegexp.MatchString(err.Error())
+}
+
+func (c *Client) GetService(ctx context.Context, params *ServiceId) (*Service, error) {
+	var resp Service
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+ServicePath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery(
+			"namespaceId", namingNamespace(params.Namespace),
+			"groupName", groupOrDefault(params.Group),
+			"serviceName", params.Name))
+	if isNotFoundError(err) {
+		return nil, fmt.Errorf("%w service=%+v", ErrNotFound, *params)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get service error: %v", err)
+	}
+
+	return &resp, nil
+}
+
+// CreateService creates a service, its clusters and instances are managed separately
+func (c *Client) CreateService(ctx context.Context, params *Service) error {
+	form, err := serviceForm(params)
+	if err != nil {
+		return fmt.Errorf("create service error: %v", err)
+	}
+
+	var resp []byte
+	err = c.request(
+		ctx, http.MethodPost, c.baseURL+ServicePath, &resp,
+		withAuthentication(c.accessToken),
+		withForm(form...))
+	if err != nil {
+		return fmt.Errorf("create service error: %v", err)
+	}
+
+	return nil
+}
+
+// UpdateService replaces the protect threshold, metadata and selector of a service
+func (c *Client) UpdateService(ctx context.Context, params *Service) error {
+	form, err := serviceForm(params)
+	if err != nil {
+		return fmt.Errorf("update service error: %v", err)
+	}
+
+	var resp []byte
+	err = c.request(
+		ctx, http.MethodPut, c.baseURL+ServicePath, &resp,
+		withAuthentication(c.accessToken),
+		withForm(form...))
+	if err != nil {
+		return fmt.Errorf("update service error: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteService deletes a service, Nacos refuses to delete services which still have instances
+func (c *Client) DeleteService(ctx context.Context, params *ServiceId) error {
+	var resp []byte
+	err := c.request(
+		ctx, http.MethodDelete, c.baseURL+ServicePath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery(
+			"namespaceId", namingNamespace(params.Namespace),
+			"groupName", groupOrDefault(params.Group),
+			"serviceName", params.Name))
+	if err != nil {
+		return fmt.Errorf("delete service error: %v", err)
+	}
+
+	return nil
+}
+
+// SearchServices returns a page of the service names of a group
+func (c *Client) SearchServices(ctx context.Context, params *SearchServicesParams) (*ServicePage, error) {
+	pageNo, pageSize := params.PageNo, params.PageSize
+	if pageNo <= 0 {
+		pageNo = 1
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultSearchPageSize
+	}
+
+	var resp ServicePage
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+ServiceListPath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery(
+			"namespaceId", namingNamespace(params.Namespace),
+			"groupName", groupOrDefault(params.Group),
+			"pageNo", strconv.Itoa(pageNo),
+			"pageSize", strconv.Itoa(pageSize)))
+	if err != nil {
+		return nil, fmt.Errorf("search services error: %v", err)
+	}
+
+	return &resp, nil
+}
+
+// ListServices walks all the pages of SearchServices, the names are sorted
+func (c *Client) ListServices(ctx context.Context, params *SearchServicesParams) ([]string, error) {
+	pageParams := *params
+	pageParams.PageNo = 1
+	if pageParams.PageSize <= 0 {
+		pageParams.PageSize = DefaultSearchPageSize
+	}
+
+	var names []string
+	for {
+		page, err := c.SearchServices(ctx, &pageParams)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, page.Services...)
+		if len(page.Services) == 0 || len(names) >= page.Count {
+			sort.Strings(names)
+			return names, nil
+		}
+		pageParams.PageNo++
+	}
+}
+
+func serviceForm(params *Service) ([]string, error) {
+	metadata := params.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := params.Selector
+	if selector == nil {
+		selector = &Selector{Type: SelectorTypeNone}
+	}
+	selectorJSON, err := json.Marshal(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{
+		"namespaceId", namingNamespace(params.Namespace),
+		"groupName", groupOrDefault(params.Group),
+		"serviceName", params.Name,
+		"protectThreshold", strconv.FormatFloat(params.ProtectThreshold, 'f', -1, 64),
+		"metadata", string(metadataJSON),
+		"selector", string(selectorJSON),
+	}, nil
+}
+
+func clusterOrDefault(cluster string) string {
+	if cluster == "" {
+		return DefaultCluster
+	}
+	return cluster
+}
+
+// RegisterInstance registers an instance, persistent instances are kept until they are deregistered
+func (c *Client) RegisterInstance(ctx context.Context, params *Instance) error {
+	form, err := instanceForm(params)
+	if err != nil {
+		return fmt.Errorf("register instance error: %v", err)
+	}
+
+	var resp []byte
+	err = c.request(
+		ctx, http.MethodPost, c.baseURL+InstancePath, &resp,
+		withAuthentication(c.accessToken),
+		withForm(form...))
+	if err != nil {
+		return fmt.Errorf("register instance error: %v", err)
+	}
+
+	return nil
+}
+
+// UpdateInstance replaces the weight, enabled flag and metadata of an instance
+func (c *Client) UpdateInstance(ctx context.Context, params *Instance) error {
+	form, err := instanceForm(params)
+	if err != nil {
+		return fmt.Errorf("update instance error: %v", err)
+	}
+
+	var resp []byte
+	err = c.request(
+		ctx, http.MethodPut, c.baseURL+InstancePath, &resp,
+		withAuthentication(c.accessToken),
+		withForm(form...))
+	if err != nil {
+		return fmt.Errorf("update instance error: %v", err)
+	}
+
+	return nil
+}
+
+func (c *Client) DeregisterInstance(ctx context.Context, params *InstanceId) error {
+	var resp []byte
+	err := c.request(
+		ctx, http.MethodDelete, c.baseURL+InstancePath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery(
+			"namespaceId", namingNamespace(params.Namespace),
+			"groupName", groupOrDefault(params.Group),
+			"serviceName", params.Service,
+			"clusterName", clusterOrDefault(params.Cluster),
+			"ip", params.Ip,
+			"port", strconv.Itoa(params.Port),
+			"ephemeral", strconv.FormatBool(params.Ephemeral)))
+	if err != nil {
+		return fmt.Errorf("deregister instance error: %v", err)
+	}
+
+	return nil
+}
+
+// ListInstances lists the instances of a service, sorted by cluster, IP and port
+func (c *Client) ListInstances(ctx context.Context, params *ListInstancesParams) ([]Instance, error) {
+	var resp instanceList
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+InstanceListPath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery(
+			"namespaceId", namingNamespace(params.Namespace),
+			"groupName", groupOrDefault(params.Group),
+			"serviceName", params.Service,
+			"clusters", strings.Join(params.Clusters, ","),
+			"healthyOnly", strconv.FormatBool(params.HealthyOnly)))
+	if err != nil {
+		return nil, fmt.Errorf("list instances error: %v", err)
+	}
+
+	instances := resp.Hosts
+	for i := range instances {
+		instances[i].Namespace = namingNamespace(params.Namespace)
+		instances[i].Group = groupOrDefault(params.Group)
+		instances[i].Service = params.Service
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		a, b := instances[i], instances[j]
+		if a.Cluster != b.Cluster {
+			return a.Cluster < b.Cluster
+		}
+		if a.Ip != b.Ip {
+			return a.Ip < b.Ip
+		}
+		return a.Port < b.Port
+	})
+	return instances, nil
+}
+
+// ListClusterInstances lists all the instances of a cluster through the catalog, sorted by IP and port.
+// Unlike ListInstances, disabled instances are listed and the health is not altered by the protect threshold
+func (c *Client) ListClusterInstances(ctx context.Context, params *ClusterId) ([]Instance, error) {
+	var instances []Instance
+	for pageNo := 1; ; pageNo++ {
+		var resp instancePage
+		err := c.request(
+			ctx, http.MethodGet, c.baseURL+CatalogInstancesPath, &resp,
+			withAuthentication(c.accessToken),
+			withQuery(
+				"namespaceId", namingNamespace(params.Namespace),
+				"groupName", groupOrDefault(params.Group),
+				"serviceName", params.Service,
+				"clusterName", clusterOrDefault(params.Name),
+				"pageNo", strconv.Itoa(pageNo),
+				"pageSize", strconv.Itoa(DefaultSearchPageSize)))
+		if err != nil {
+			return nil, fmt.Errorf("list cluster instances error: %v", err)
+		}
+
+		instances = append(instances, resp.List...)
+		if len(resp.List) == 0 || len(instances) >= resp.Count {
+			break
+		}
+	}
+
+	for i := range instances {
+		instances[i].Namespace = namingNamespace(params.Namespace)
+		instances[i].Group = groupOrDefault(params.Group)
+		instances[i].Service = params.Service
+		instances[i].Cluster = clusterOrDefault(params.Name)
+	}
+	sort.Slice(instances, func(i, j int) bool {
+		a, b := instances[i], instances[j]
+		if a.Ip != b.Ip {
+			return a.Ip < b.Ip
+		}
+		return a.Port < b.Port
+	})
+	return instances, nil
+}
+
+// ListServiceInstances lists all the instances of the clusters of a service, enabled or not, sorted by cluster, IP and port
+func (c *Client) ListServiceInstances(ctx context.Context, params *ServiceId) ([]Instance, error) {
+	service, err := c.GetService(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []Instance
+	for _, cluster := range service.Clusters {
+		clusterInstances, err := c.ListClusterInstances(ctx, &ClusterId{
+			Namespace: params.Namespace,
+			Group:     params.Group,
+			Service:   params.Name,
+			Name:      cluster.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, clusterInstances...)
+	}
+
+	sort.SliceStable(instances, func(i, j int) bool { return instances[i].Cluster < instances[j].Cluster })
+	return instances, nil
+}
+
+// GetInstance finds an instance in the catalog of its cluster, enabled or not
+func (c *Client) GetInstance(ctx context.Context, params *InstanceId) (*Instance, error) {
+	instances, err := c.ListClusterInstances(ctx, &ClusterId{
+		Namespace: params.Namespace,
+		Group:     params.Group,
+		Service:   params.Service,
+		Name:      params.Cluster,
+	})
+	if isNotFoundError(err) {
+		return nil, fmt.Errorf("%w instance=%+v", ErrNotFound, *params)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get instance error: %v", err)
+	}
+
+	for _, instance := range instances {
+		if instance.Ip == params.Ip && instance.Port == params.Port {
+			return &instance, nil
+		}
+	}
+	return nil, fmt.Errorf("%w instance=%+v", ErrNotFound, *params)
+}
+
+// UpdateInstanceHealth overrides the health of an instance,
+// Nacos only accepts it for instances which are not health checked, e.g. in a cluster with the NONE health checker
+func (c *Client) UpdateInstanceHealth(ctx context.Context, params *InstanceId, healthy bool) error {
+	group := groupOrDefault(params.Group)
+	var resp []byte
+	err := c.request(
+		ctx, http.MethodPut, c.baseURL+HealthPath, &resp,
+		withAuthentication(c.accessToken),
+		withForm(
+			"namespaceId", namingNamespace(params.Namespace),
+			"groupName", group,
+			"serviceName", group+"@@"+params.Service,
+			"clusterName", clusterOrDefault(params.Cluster),
+			"ip", params.Ip,
+			"port", strconv.Itoa(params.Port),
+			"healthy", strconv.FormatBool(healthy)))
+	if err != nil {
+		return fmt.Errorf("update instance health error: %v", err)
+	}
+
+	return nil
+}
+
+func instanceForm(params *Instance) ([]string, error) {
+	metadata := params.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{
+		"namespaceId", namingNamespace(params.Namespace),
+		"groupName", groupOrDefault(params.Group),
+		"serviceName", params.Service,
+		"clusterName", clusterOrDefault(params.Cluster),
+		"ip", params.Ip,
+		"port", strconv.Itoa(params.Port),
+		"weight", strconv.FormatFloat(params.Weight, 'f', -1, 64),
+		"enabled", strconv.FormatBool(params.Enabled),
+		"healthy", strconv.FormatBool(params.Healthy),
+		"ephemeral", strconv.FormatBool(params.Ephemeral),
+		"metadata", string(metadataJSON),
+	}, nil
+}
+
+// GetCluster finds a cluster in the detail of its service
+func (c *Client) GetCluster(ctx context.Context, params *ClusterId) (*Cluster, error) {
+	service, err := c.GetService(ctx, &ServiceId{
+		Namespace: params.Namespace,
+		Group:     params.Group,
+		Name:      params.Service,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get cluster error: %w", err)
+	}
+
+	for _, cluster := range service.Clusters {
+		if cluster.Name == params.Name {
+			cluster.Namespace = service.Namespace
+			cluster.Group = service.Group
+			cluster.Service = params.Service
+			return &cluster, nil
+		}
+	}
+	return nil, fmt.Errorf("%w cluster=%+v", ErrNotFound, *params)
+}
+
+// UpdateCluster replaces the health checker and metadata of a cluster, which is created when it does not exist
+func (c *Client) UpdateCluster(ctx context.Context, params *Cluster) error {
+	healthChecker := params.HealthChecker
+	if healthChecker == nil {
+		healthChecker = &HealthChecker{Type: HealthCheckerTCP}
+	}
+	healthCheckerJSON, err := json.Marshal(healthChecker)
+	if err != nil {
+		return fmt.Errorf("update cluster error: %v", err)
+	}
+	metadata := params.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("update cluster error: %v", err)
+	}
+	checkPort := DefaultCheckPort
+	if params.CheckPort != nil {
+		checkPort = *params.CheckPort
+	}
+	useIPPort4Check := true
+	if params.UseIPPort4Check != nil {
+		useIPPort4Check = *params.UseIPPort4Check
+	}
+
+	group := groupOrDefault(params.Group)
+	var resp []byte
+	err = c.request(
+		ctx, http.MethodPut, c.baseURL+ClusterPath, &resp,
+		withAuthentication(c.accessToken),
+		withForm(
+			"namespaceId", namingNamespace(params.Namespace),
+			"groupName", group,
+			// the cluster API expects the grouped service name
+			"serviceName", group+"@@"+params.Service,
+			"clusterName", params.Name,
+			"checkPort", strconv.Itoa(checkPort),
+			"useInstancePort4Check", strconv.FormatBool(useIPPort4Check),
+			"healthChecker", string(healthCheckerJSON),
+			"metadata", string(metadataJSON)))
+	if err != nil {
+		return fmt.Errorf("update cluster error: %v", err)
+	}
+
+	return nil
+}
+
+// UpdateInstancesMetadata adds or replaces metadata entries on instances of a service, other entries are kept
+func (c *Client) UpdateInstancesMetadata(ctx context.Context, params *InstancesMetadataParams) (*InstancesMetadataResult, error) {
+	result, err := c.batchInstancesMetadata(ctx, http.MethodPut, params)
+	if err != nil {
+		return nil, fmt.Errorf("update instances metadata error: %v", err)
+	}
+	return result, nil
+}
+
+// DeleteInstancesMetadata deletes the metadata entries of params.Metadata on instances of a service, their values are ignored
+func (c *Client) DeleteInstancesMetadata(ctx context.Context, params *InstancesMetadataParams) (*InstancesMetadataResult, error) {
+	result, err := c.batchInstancesMetadata(ctx, http.MethodDelete, params)
+	if err != nil {
+		return nil, fmt.Errorf("delete instances metadata error: %v", err)
+	}
+	return result, nil
+}
+
+func (c *Client) batchInstancesMetadata(ctx context.Context, method string, params *InstancesMetadataParams) (*InstancesMetadataResult, error) {
+	metadataJSON, err := json.Marshal(params.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	instances := ""
+	if len(params.Instances) > 0 {
+		targets := make([]InstancesMetadataTarget, len(params.Instances))
+		for i, target := range params.Instances {
+			target.Cluster = clusterOrDefault(target.Cluster)
+			target.Ephemeral = params.ConsistencyType != ConsistencyTypePersist
+			targets[i] = target
+		}
+		instancesJSON, err := json.Marshal(targets)
+		if err != nil {
+			return nil, err
+		}
+		instances = string(instancesJSON)
+	}
+
+	group := groupOrDefault(params.Group)
+	form := []string{
+		"namespaceId", namingNamespace(params.Namespace),
+		"groupName", group,
+		"serviceName", group + "@@" + params.Service,
+		"consistencyType", params.ConsistencyType,
+		"metadata", string(metadataJSON),
+	}
+	if instances != "" {
+		form = append(form, "instances", instances)
+	}
+
+	var resp InstancesMetadataResult
+	opts := []requestOptionFn{withAuthentication(c.accessToken)}
+	// DELETE requests carry no body
+	if method == http.MethodDelete {
+		opts = append(opts, withQuery(form...))
+	} else {
+		opts = append(opts, withForm(form...))
+	}
+	if err := c.request(ctx, method, c.baseURL+MetadataPath, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSwitches reads the cluster-wide naming switches
+func (c *Client) GetSwitches(ctx context.Context) (*Switches, error) {
+	var resp Switches
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+SwitchesPath, &resp,
+		withAuthentication(c.accessToken))
+	if err != nil {
+		return nil, fmt.Errorf("get switches error: %v", err)
+	}
+
+	return &resp, nil
+}
+
+// UpdateSwitch updates one entry of the naming switches on the whole cluster
+func (c *Client) UpdateSwitch(ctx context.Context, entry, value string) error {
+	var resp []byte
+	err := c.request(
+		ctx, http.MethodPut, c.baseURL+SwitchesPath, &resp,
+		withAuthentication(c.accessToken),
+		withForm(
+			"entry", entry,
+			"value", value,
+			"debug", "false"))
+	if err != nil {
+		return fmt.Errorf("update switch error: %v", err)
+	}
+
+	return nil
+}
+
+// GetMetrics reads the metrics of the naming module on the server
+func (c *Client) GetMetrics(ctx context.Context) (*NamingMetrics, error) {
+	var resp NamingMetrics
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+MetricsPath, &resp,
+		withAuthentication(c.accessToken),
+		withQuery("onlyStatus", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("get metrics error: %v", err)
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,541 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	_ServicePath          = "/nacos/v1/ns/service"
+	_ServiceListPath      = "/nacos/v1/ns/service/list"
+	_InstancePath         = "/nacos/v1/ns/instance"
+	_InstanceListPath     = "/nacos/v1/ns/instance/list"
+	_CatalogInstancesPath = "/nacos/v1/ns/catalog/instances"
+	_ClusterPath          = "/nacos/v1/ns/cluster"
+	_MetadataPath         = "/nacos/v1/ns/instance/metadata/batch"
+	_HealthPath           = "/nacos/v1/ns/health/instance"
+	_SwitchesPath         = "/nacos/v1/ns/operator/switches"
+	_MetricsPath          = "/nacos/v1/ns/operator/metrics"
+)
+
+func newNamingTestClient(t *testing.T, path string, handler http.HandlerFunc) (*Client, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case _LoginPath:
+			defaultLoginHandler(w, r)
+
+		case path:
+			handler(w, r)
+
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+
+	client, err := NewClient(&Config{
+		Address:     server.URL,
+		ContextPath: "nacos",
+	})
+	assert.Nil(t, err)
+	return client, server.Close
+}
+
+func TestClient_GetService(t *testing.T) {
+	tests := []struct {
+		name           string
+		serviceHandler http.HandlerFunc
+		expectErr      string
+		expectRes      *Service
+	}{
+		{
+			name: "not found",
+			serviceHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte("caused: service DEFAULT_GROUP@@service is not found!;"))
+			},
+			expectErr: "not found service",
+		},
+		{
+			name: "request error",
+			serviceHandler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expectErr: "get service error",
+		},
+		{
+			name: "success",
+			serviceHandler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, PublicNamespace, r.URL.Query().Get("namespaceId"))
+				assert.Equal(t, DefaultGroup, r.URL.Query().Get("groupName"))
+				assert.Equal(t, "service", r.URL.Query().Get("serviceName"))
+
+				jsonResp, _ := json.Marshal(map[string]interface{}{
+					"namespaceId":      PublicNamespace,
+					"groupName":        DefaultGroup,
+					"name":             "service",
+					"protectThreshold": 0.5,
+					"metadata":         map[string]string{"owner": "team"},
+					"selector":         map[string]string{"type": "none"},
+				})
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write(jsonResp)
+			},
+			expectRes: &Service{
+				Namespace:        PublicNamespace,
+				Group:            DefaultGroup,
+				Name:             "service",
+				ProtectThreshold: 0.5,
+				Metadata:         map[string]string{"owner": "team"},
+				Selector:         &Selector{Type: SelectorTypeNone},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, closeServer := newNamingTestClient(t, _ServicePath, tt.serviceHandler)
+			defer closeServer()
+
+			res, err := client.GetService(context.Background(), &ServiceId{Name: "service"})
+			if tt.expectErr != "" {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectErr)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.expectRes, res)
+			}
+		})
+	}
+}
+
+func TestClient_CreateUpdateDeleteService(t *testing.T) {
+	var methods []string
+	client, closeServer := newNamingTestClient(t, _ServicePath, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		_ = r.ParseForm()
+		assert.Equal(t, "sandbox", r.Form.Get("namespaceId"))
+		assert.Equal(t, "GROUP", r.Form.Get("groupName"))
+		assert.Equal(t, "service", r.Form.Get("serviceName"))
+		if r.Method != http.MethodDelete {
+			assert.Equal(t, "0.8", r.Form.Get("protectThreshold"))
+			assert.JSONEq(t, `{"owner":"team"}`, r.Form.Get("metadata"))
+			assert.JSONEq(t, `{"type":"none"}`, r.Form.Get("selector"))
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+	defer closeServer()
+
+	service := &Service{
+		Namespace:        "sandbox",
+		Group:            "GROUP",
+		Name:             "service",
+		ProtectThreshold: 0.8,
+		Metadata:         map[string]string{"owner": "team"},
+	}
+	assert.Nil(t, client.CreateService(context.Background(), service))
+	assert.Nil(t, client.UpdateService(context.Background(), service))
+	assert.Nil(t, client.DeleteService(context.Background(), &ServiceId{Namespace: "sandbox", Group: "GROUP", Name: "service"}))
+	assert.Equal(t, []string{http.MethodPost, http.MethodPut, http.MethodDelete}, methods)
+}
+
+func TestClient_ListInstances(t *testing.T) {
+	client, closeServer := newNamingTestClient(t, _InstanceListPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "sandbox", r.URL.Query().Get("namespaceId"))
+		assert.Equal(t, DefaultGroup, r.URL.Query().Get("groupName"))
+		assert.Equal(t, "service", r.URL.Query().Get("serviceName"))
+		assert.Equal(t, "DEFAULT", r.URL.Query().Get("clusters"))
+
+		jsonResp, _ := json.Marshal(map[string]interface{}{
+			"name": "DEFAULT_GROUP@@service",
+			"hosts": []map[string]interface{}{
+				{"ip": "10.0.0.2", "port": 3306, "clusterName": "DEFAULT", "weight": 1.0, "enabled": true, "healthy": false},
+				{"ip": "10.0.0.1", "port": 3306, "clusterName": "DEFAULT", "weight": 2.0, "enabled": true, "healthy": true,
+					"metadata": map[string]string{"zone": "a"}},
+			},
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(jsonResp)
+	})
+	defer closeServer()
+
+	instances, err := client.ListInstances(context.Background(), &ListInstancesParams{
+		Namespace: "sandbox",
+		Service:   "service",
+		Clusters:  []string{DefaultCluster},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, instances, 2)
+	assert.Equal(t, Instance{
+		Namespace: "sandbox",
+		Group:     DefaultGroup,
+		Service:   "service",
+		Cluster:   DefaultCluster,
+		Ip:        "10.0.0.1",
+		Port:      3306,
+		Weight:    2,
+		Enabled:   true,
+		Healthy:   true,
+		Metadata:  map[string]string{"zone": "a"},
+	}, instances[0])
+}
+
+func TestClient_GetInstance(t *testing.T) {
+	var pages []string
+	client, closeServer := newNamingTestClient(t, _CatalogInstancesPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "sandbox", r.URL.Query().Get("namespaceId"))
+		assert.Equal(t, DefaultGroup, r.URL.Query().Get("groupName"))
+		assert.Equal(t, "service", r.URL.Query().Get("serviceName"))
+		assert.Equal(t, DefaultCluster, r.URL.Query().Get("clusterName"))
+		pages = append(pages, r.URL.Query().Get("pageNo"))
+
+		// one instance per page, the disabled instance is on the second one
+		instances := map[string][]map[string]interface{}{
+			"1": {{"ip": "10.0.0.2", "port": 3306, "clusterName": "DEFAULT", "weight": 1.0, "enabled": true, "healthy": true}},
+			"2": {{"ip": "10.0.0.1", "port": 3306, "clusterName": "DEFAULT", "weight": 2.0, "enabled": false, "healthy": false,
+				"ephemeral": false, "metadata": map[string]string{"zone": "a"}}},
+		}[r.URL.Query().Get("pageNo")]
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"count": 2, "list": instances})
+	})
+	defer closeServer()
+
+	instance, err := client.GetInstance(context.Background(), &InstanceId{
+		Namespace: "sandbox",
+		Service:   "service",
+		Ip:        "10.0.0.1",
+		Port:      3306,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", "2"}, pages)
+	assert.Equal(t, &Instance{
+		Namespace: "sandbox",
+		Group:     DefaultGroup,
+		Service:   "service",
+		Cluster:   DefaultCluster,
+		Ip:        "10.0.0.1",
+		Port:      3306,
+		Weight:    2,
+		Enabled:   false,
+		Healthy:   false,
+		Metadata:  map[string]string{"zone": "a"},
+	}, instance)
+
+	_, err = client.GetInstance(context.Background(), &InstanceId{
+		Namespace: "sandbox",
+		Service:   "service",
+		Ip:        "10.0.0.3",
+		Port:      3306,
+	})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "not found instance")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestClient_GetInstanceOfMissingService(t *testing.T) {
+	client, closeServer := newNamingTestClient(t, _CatalogInstancesPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("caused: serivce DEFAULT_GROUP@@service is not found!;"))
+	})
+	defer closeServer()
+
+	_, err := client.GetInstance(context.Background(), &InstanceId{Service: "service", Ip: "10.0.0.1", Port: 3306})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "not found instance")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestClient_RegisterDeregisterInstance(t *testing.T) {
+	var methods []string
+	client, closeServer := newNamingTestClient(t, _InstancePath, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		_ = r.ParseForm()
+		assert.Equal(t, PublicNamespace, r.Form.Get("namespaceId"))
+		assert.Equal(t, "service", r.Form.Get("serviceName"))
+		assert.Equal(t, DefaultCluster, r.Form.Get("clusterName"))
+		assert.Equal(t, "10.0.0.1", r.Form.Get("ip"))
+		assert.Equal(t, "3306", r.Form.Get("port"))
+		assert.Equal(t, "false", r.Form.Get("ephemeral"))
+		if r.Method != http.MethodDelete {
+			assert.Equal(t, "1.5", r.Form.Get("weight"))
+			assert.JSONEq(t, `{"zone":"a"}`, r.Form.Get("metadata"))
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+	defer closeServer()
+
+	instance := &Instance{
+		Service:  "service",
+		Ip:       "10.0.0.1",
+		Port:     3306,
+		Weight:   1.5,
+		Enabled:  true,
+		Healthy:  true,
+		Metadata: map[string]string{"zone": "a"},
+	}
+	assert.Nil(t, client.RegisterInstance(context.Background(), instance))
+	assert.Nil(t, client.UpdateInstance(context.Background(), instance))
+	assert.Nil(t, client.DeregisterInstance(context.Background(), &InstanceId{Service: "service", Ip: "10.0.0.1", Port: 3306}))
+	assert.Equal(t, []string{http.MethodPost, http.MethodPut, http.MethodDelete}, methods)
+}
+
+func TestClient_GetCluster(t *testing.T) {
+	client, closeServer := newNamingTestClient(t, _ServicePath, func(w http.ResponseWriter, r *http.Request) {
+		jsonResp, _ := json.Marshal(map[string]interface{}{
+			"namespaceId": "sandbox",
+			"groupName":   DefaultGroup,
+			"name":        "mysql",
+			"clusters": []map[string]interface{}{
+				{
+					"name":          "DEFAULT",
+					"healthChecker": map[string]interface{}{"type": "MYSQL", "user": "nacos", "cmd": "select 1"},
+					"metadata":      map[string]string{"zone": "a"},
+				},
+			},
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(jsonResp)
+	})
+	defer closeServer()
+
+	cluster, err := client.GetCluster(context.Background(), &ClusterId{Namespace: "sandbox", Service: "mysql", Name: "DEFAULT"})
+	assert.Nil(t, err)
+	assert.Equal(t, &Cluster{
+		Namespace:     "sandbox",
+		Group:         DefaultGroup,
+		Service:       "mysql",
+		Name:          "DEFAULT",
+		HealthChecker: &HealthChecker{Type: HealthCheckerMySQL, User: "nacos", Cmd: "select 1"},
+		Metadata:      map[string]string{"zone": "a"},
+	}, cluster)
+
+	_, err = client.GetCluster(context.Background(), &ClusterId{Namespace: "sandbox", Service: "mysql", Name: "OTHER"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "not found cluster")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestClient_GetClusterOfMissingService(t *testing.T) {
+	client, closeServer := newNamingTestClient(t, _ServicePath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("caused: service DEFAULT_GROUP@@mysql is not found!;"))
+	})
+	defer closeServer()
+
+	_, err := client.GetCluster(context.Background(), &ClusterId{Namespace: "sandbox", Service: "mysql", Name: "DEFAULT"})
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestClient_UpdateCluster(t *testing.T) {
+	client, closeServer := newNamingTestClient(t, _ClusterPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		_ = r.ParseForm()
+		assert.Equal(t, "sandbox", r.Form.Get("namespaceId"))
+		assert.Equal(t, "DEFAULT_GROUP@@web", r.Form.Get("serviceName"))
+		assert.Equal(t, "DEFAULT", r.Form.Get("clusterName"))
+		assert.Equal(t, "8080", r.Form.Get("checkPort"))
+		assert.Equal(t, "false", r.Form.Get("useInstancePort4Check"))
+		assert.JSONEq(t, `{"type":"HTTP","path":"/health","expectedResponseCode":200}`, r.Form.Get("healthChecker"))
+		assert.JSONEq(t, `{}`, r.Form.Get("metadata"))
+		_, _ = w.Write([]byte("ok"))
+	})
+	defer closeServer()
+
+	checkPort, useIPPort4Check := 8080, false
+	err := client.UpdateCluster(context.Background(), &Cluster{
+		Namespace:       "sandbox",
+		Service:         "web",
+		Name:            "DEFAULT",
+		HealthChecker:   &HealthChecker{Type: HealthCheckerHTTP, Path: "/health", ExpectedResponseCode: 200},
+		CheckPort:       &checkPort,
+		UseIPPort4Check: &useIPPort4Check,
+	})
+	assert.Nil(t, err)
+}
+
+func TestClient_ListServices(t *testing.T) {
+	pages := map[string][]string{
+		"1": {"c", "a"},
+		"2": {"b"},
+	}
+	client, closeServer := newNamingTestClient(t, _ServiceListPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "sandbox", r.URL.Query().Get("namespaceId"))
+		assert.Equal(t, "GROUP", r.URL.Query().Get("groupName"))
+		assert.Equal(t, "2", r.URL.Query().Get("pageSize"))
+
+		jsonResp, _ := json.Marshal(map[string]interface{}{
+			"count": 3,
+			"doms":  pages[r.URL.Query().Get("pageNo")],
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(jsonResp)
+	})
+	defer closeServer()
+
+	names, err := client.ListServices(context.Background(), &SearchServicesParams{
+		Namespace: "sandbox",
+		Group:     "GROUP",
+		PageSize:  2,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+}
+
+func TestClient_InstancesMetadata(t *testing.T) {
+	var methods []string
+	client, closeServer := newNamingTestClient(t, _MetadataPath, func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		_ = r.ParseForm()
+		assert.Equal(t, "DEFAULT_GROUP@@web", r.Form.Get("serviceName"))
+		assert.Equal(t, ConsistencyTypeEphemeral, r.Form.Get("consistencyType"))
+		assert.JSONEq(t, `{"zone":"a"}`, r.Form.Get("metadata"))
+		assert.JSONEq(t, `[{"ip":"10.0.0.1","port":8080,"clusterName":"DEFAULT","ephemeral":true}]`, r.Form.Get("instances"))
+
+		jsonResp, _ := json.Marshal(map[string]interface{}{
+			"updated": []string{"10.0.0.1:8080:ephemeral:DEFAULT"},
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(jsonResp)
+	})
+	defer closeServer()
+
+	params := &InstancesMetadataParams{
+		Service:         "web",
+		ConsistencyType: ConsistencyTypeEphemeral,
+		Instances:       []InstancesMetadataTarget{{Ip: "10.0.0.1", Port: 8080}},
+		Metadata:        map[string]string{"zone": "a"},
+	}
+	result, err := client.UpdateInstancesMetadata(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"10.0.0.1:8080:ephemeral:DEFAULT"}, result.Updated)
+
+	_, err = client.DeleteInstancesMetadata(context.Background(), params)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{http.MethodPut, http.MethodDelete}, methods)
+}
+
+func TestClient_UpdateInstanceHealth(t *testing.T) {
+	client, closeServer := newNamingTestClient(t, _HealthPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		_ = r.ParseForm()
+		assert.Equal(t, "DEFAULT_GROUP@@mysql", r.Form.Get("serviceName"))
+		assert.Equal(t, DefaultCluster, r.Form.Get("clusterName"))
+		assert.Equal(t, "10.0.0.1", r.Form.Get("ip"))
+		assert.Equal(t, "3306", r.Form.Get("port"))
+		assert.Equal(t, "false", r.Form.Get("healthy"))
+		_, _ = w.Write([]byte("ok"))
+	})
+	defer closeServer()
+
+	err := client.UpdateInstanceHealth(context.Background(), &InstanceId{Service: "mysql", Ip: "10.0.0.1", Port: 3306}, false)
+	assert.Nil(t, err)
+}
+
+func TestClient_Switches(t *testing.T) {
+	var updates []string
+	client, closeServer := newNamingTestClient(t, _SwitchesPath, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"name":                   "00-00---000-NACOS_SWITCH_DOMAIN-000---00-00",
+				"distroThreshold":        0.7,
+				"healthCheckEnabled":     true,
+				"checkTimes":             3,
+				"clientBeatInterval":     5000,
+				"defaultPushCacheMillis": 10000,
+				"serviceStatusSynchronizationPeriodMillis": 5000,
+				"pushJavaVersion":                          "0.1.0",
+			})
+		case http.MethodPut:
+			_ = r.ParseForm()
+			assert.Equal(t, "false", r.Form.Get("debug"))
+			updates = append(updates, r.Form.Get("entry")+"="+r.Form.Get("value"))
+			_, _ = w.Write([]byte("ok"))
+		}
+	})
+	defer closeServer()
+
+	switches, err := client.GetSwitches(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 0.7, switches.DistroThreshold)
+	assert.True(t, switches.HealthCheckEnabled)
+	assert.Equal(t, 3, switches.CheckTimes)
+	assert.Equal(t, int64(5000), switches.ClientBeatInterval)
+	assert.Equal(t, int64(10000), switches.DefaultPushCacheMillis)
+	assert.Equal(t, int64(5000), switches.ServiceStatusSynchronizationPeriodMillis)
+	assert.Equal(t, "0.1.0", switches.PushJavaVersion)
+
+	assert.Nil(t, client.UpdateSwitch(context.Background(), SwitchEntryHealthCheckEnabled, "false"))
+	assert.Nil(t, client.UpdateSwitch(context.Background(), SwitchEntryHealthCheckTimes, "5"))
+	assert.Nil(t, client.UpdateSwitch(context.Background(), SwitchEntryPushVersion, PushVersionValue(PushClientJava, "1.2.0")))
+	assert.Equal(t, []string{"check=false", "healthCheckTimes=5", "pushVersion=java:1.2.0"}, updates)
+}
+
+func TestClient_GetMetrics(t *testing.T) {
+	client, closeServer := newNamingTestClient(t, _MetricsPath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "false", r.URL.Query().Get("onlyStatus"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status":                   "UP",
+			"serviceCount":             12,
+			"instanceCount":            30,
+			"subscribeCount":           7,
+			"responsibleServiceCount":  6,
+			"responsibleInstanceCount": 15,
+			"cpu":                      0.1,
+		})
+	})
+	defer closeServer()
+
+	metrics, err := client.GetMetrics(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, &NamingMetrics{
+		Status:                   "UP",
+		ServiceCount:             12,
+		InstanceCount:            30,
+		SubscribeCount:           7,
+		ResponsibleServiceCount:  6,
+		ResponsibleInstanceCount: 15,
+	}, metrics)
+}
+
+func TestClient_ListServiceInstances(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case _LoginPath:
+			defaultLoginHandler(w, r)
+
+		case _ServicePath:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"name":     "service",
+				"clusters": []map[string]interface{}{{"name": "ZONE-B"}, {"name": "ZONE-A"}},
+			})
+
+		case _CatalogInstancesPath:
+			cluster := r.URL.Query().Get("clusterName")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"count": 1,
+				"list":  []map[string]interface{}{{"ip": "10.0.0.1", "port": 80, "clusterName": cluster, "enabled": cluster == "ZONE-A"}},
+			})
+
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{Address: server.URL, ContextPath: "nacos"})
+	assert.Nil(t, err)
+
+	instances, err := client.ListServiceInstances(context.Background(), &ServiceId{Name: "service"})
+	assert.Nil(t, err)
+	assert.Len(t, instances, 2)
+	assert.Equal(t, "ZONE-A", instances[0].Cluster)
+	assert.True(t, instances[0].Enabled)
+	assert.Equal(t, "ZONE-B", instances[1].Cluster)
+	assert.False(t, instances[1].Enabled)
+}
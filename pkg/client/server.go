@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+)
+
+const (
+	ClusterNodesPath = "core/cluster/nodes"
+	RaftLeaderPath   = "ns/raft/leader"
+	ReadinessPath    = "console/health/readiness"
+	ServerStatePath  = "console/server/state"
+
+	MemberStateUp = "UP"
+)
+
+// ListClusterMembers lists the members of the Nacos cluster, sorted by address
+func (c *Client) ListClusterMembers(ctx context.Context) ([]ClusterMember, error) {
+	var members []ClusterMember
+	resp := restResult{Data: &members}
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+ClusterNodesPath, &resp,
+		withAuthentication(c.accessToken))
+	if err == nil {
+		err = resp.err()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list cluster members error: %v", err)
+	}
+
+	sort.Slice(members, func(i, j int) bool { return members[i].Address < members[j].Address })
+	return members, nil
+}
+
+// GetRaftLeader reads the leader of the raft group of the naming module,
+// which is returned as a JSON encoded string
+func (c *Client) GetRaftLeader(ctx context.Context) (*RaftPeer, error) {
+	var resp raftLeader
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+RaftLeaderPath, &resp,
+		withAuthentication(c.accessToken))
+	if err != nil {
+		return nil, fmt.Errorf("get raft leader error: %v", err)
+	}
+
+	var leader *RaftPeer
+	if err := json.Unmarshal([]byte(resp.Leader), &leader); err != nil {
+		return nil, fmt.Errorf("get raft leader error: failed to unmarshal leader = %s: %v", resp.Leader, err)
+	}
+	if leader == nil {
+		return nil, fmt.Errorf("get raft leader error: no leader")
+	}
+	return leader, nil
+}
+
+var notReadyErrorRegexp = regexp.MustCompile(`request error status_code = 5\d\d, body = (.*)`)
+
+// GetReadiness checks whether the server is ready to serve requests,
+// a server which is not ready is reported in the result rather than as an error
+func (c *Client) GetReadiness(ctx context.Context) (*Readiness, error) {
+	var resp []byte
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+ReadinessPath, &resp,
+		withAuthentication(c.accessToken))
+	if err != nil {
+		if matches := notReadyErrorRegexp.FindStringSubmatch(err.Error()); matches != nil {
+			return &Readiness{Ready: false, Message: matches[1]}, nil
+		}
+		return nil, fmt.Errorf("get readiness error: %v", err)
+	}
+
+	return &Readiness{Ready: true, Message: string(resp)}, nil
+}
+
+// GetServerState reads the state of the server, e.g. its version and mode,
+// entries without value are left out
+func (c *Client) GetServerState(ctx context.Context) (*ServerState, error) {
+	var resp map[string]interface{}
+	err := c.request(
+		ctx, http.MethodGet, c.baseURL+ServerStatePath, &resp,
+		withAuthentication(c.accessToken))
+	if err != nil {
+		return nil, fmt.Errorf("get server state error: %v", err)
+	}
+
+	state := &ServerState{Entries: make(map[string]string, len(resp))}
+	for k, v := range resp {
+		if v != nil {
+			state.Entries[k] = fmt.Sprint(v)
+		}
+	}
+	state.Version = state.Entries["version"]
+	state.StandaloneMode = state.Entries["standalone_mode"]
+	state.FunctionMode = state.Entries["function_mode"]
+	return state, nil
+}
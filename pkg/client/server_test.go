@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	_ClusterNodesPath = "/nacos/v1/core/cluster/nodes"
+	_RaftLeaderPath   = "/nacos/v1/ns/raft/leader"
+	_ReadinessPath    = "/nacos/v1/console/health/readiness"
+	_ServerStatePath  = "/nacos/v1/console/server/state"
+)
+
+func TestClient_ListClusterMembers(t *testing.T) {
+	client, closeServer := newNamingTestClient(t, _ClusterNodesPath, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"code": 200,
+			"data": []map[string]interface{}{
+				{
+					"ip": "10.0.0.2", "port": 8848, "address": "10.0.0.2:8848", "state": "DOWN", "failAccessCnt": 3,
+					"extendInfo": map[string]interface{}{"version": "1.4.1"},
+				},
+				{
+					"ip": "10.0.0.1", "port": 8848, "address": "10.0.0.1:8848", "state": "UP",
+					"extendInfo": map[string]interface{}{
+						"version": "1.4.1",
+						"raftMetaData": map[string]interface{}{
+							"metaDataMap": map[string]interface{}{
+								"naming_persistent_service": map[string]interface{}{
+									"leader":          "10.0.0.1:7848",
+									"raftGroupMember": []string{"10.0.0.1:7848", "10.0.0.2:7848"},
+									"term":            2,
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	})
+	defer closeServer()
+
+	members, err := client.ListClusterMembers(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, members, 2)
+	assert.Equal(t, "10.0.0.1:8848", members[0].Address)
+	assert.Equal(t, MemberStateUp, members[0].State)
+	assert.Equal(t, "1.4.1", members[0].ExtendInfo.Version)
+	assert.Equal(t, RaftGroup{
+		Leader:  "10.0.0.1:7848",
+		Members: []string{"10.0.0.1:7848", "10.0.0.2:7848"},
+		Term:    2,
+	}, members[0].ExtendInfo.RaftMetadata.Groups["naming_persistent_service"])
+	assert.Equal(t, 3, members[1].FailAccessCount)
+}
+
+func TestClient_GetRaftLeader(t *testing.T) {
+	tests := []struct {
+		name      string
+		leader    string
+		expectErr string
+		expectRes *RaftPeer
+	}{
+		{
+			name:      "leader",
+			leader:    `{"ip":"10.0.0.1:8848","voteFor":"10.0.0.1:8848","term":3,"state":"LEADER"}`,
+			expectRes: &RaftPeer{Ip: "10.0.0.1:8848", VoteFor: "10.0.0.1:8848", Term: 3, State: "LEADER"},
+		},
+		{
+			name:      "no leader",
+			leader:    "null",
+			expectErr: "no leader",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, closeServer := newNamingTestClient(t, _RaftLeaderPath, func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]string{"leader": tc.leader})
+			})
+			defer closeServer()
+
+			leader, err := client.GetRaftLeader(context.Background())
+			if tc.expectErr != "" {
+				assert.Contains(t, err.Error(), tc.expectErr)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectRes, leader)
+		})
+	}
+}
+
+func TestClient_GetReadiness(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		expectRes *Readiness
+	}{
+		{
+			name:      "ready",
+			status:    http.StatusOK,
+			body:      "OK",
+			expectRes: &Readiness{Ready: true, Message: "OK"},
+		},
+		{
+			name:      "not ready",
+			status:    http.StatusInternalServerError,
+			body:      "Config and Naming are not in readiness",
+			expectRes: &Readiness{Ready: false, Message: "Config and Naming are not in readiness"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, closeServer := newNamingTestClient(t, _ReadinessPath, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			})
+			defer closeServer()
+
+			readiness, err := client.GetReadiness(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectRes, readiness)
+		})
+	}
+}
+
+func TestClient_GetServerState(t *testing.T) {
+	client, closeServer := newNamingTestClient(t, _ServerStatePath, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"version":"1.4.1","standalone_mode":"cluster","function_mode":null}`))
+	})
+	defer closeServer()
+
+	state, err := client.GetServerState(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, &ServerState{
+		Version:        "1.4.1",
+		StandaloneMode: "cluster",
+		Entries:        map[string]string{"version": "1.4.1", "standalone_mode": "cluster"},
+	}, state)
+}